@@ -0,0 +1,10 @@
+package main
+
+import (
+	"github.com/yssk22/go-generators/enum/exhaustive"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(exhaustive.Analyzer)
+}
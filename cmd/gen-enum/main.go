@@ -0,0 +1,253 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/yssk22/go-generators/enum"
+	"github.com/yssk22/go-generators/enum/bitflag"
+	"github.com/yssk22/go-generators/enum/docs"
+	"github.com/yssk22/go-generators/enum/entgo"
+	"github.com/yssk22/go-generators/enum/gqlgen"
+	"github.com/yssk22/go-generators/enum/json"
+	"github.com/yssk22/go-generators/enum/jsonschema"
+	"github.com/yssk22/go-generators/enum/label"
+	"github.com/yssk22/go-generators/enum/ordered"
+	"github.com/yssk22/go-generators/enum/protobuf"
+	"github.com/yssk22/go-generators/enum/registrygen"
+	"github.com/yssk22/go-generators/enum/sql"
+	"github.com/yssk22/go-generators/enum/stringer"
+	"github.com/yssk22/go-generators/enum/text"
+	"github.com/yssk22/go-generators/enum/tmpl"
+	"github.com/yssk22/go-generators/enum/transition"
+	"github.com/yssk22/go-generators/enum/typescript"
+	"github.com/yssk22/go-generators/enum/validator"
+	"github.com/yssk22/go-generators/helper"
+)
+
+const usage = `Usage:
+	gen-enum -targets=gqlgen,entgo,... [-type=MyEnum,...] [-output=filename] [-template=file.tmpl,...] [-docs=filename] [packages]
+
+packages are directories or patterns such as ./... (default: .)
+`
+
+// targets are the generators available by -targets. Each generator is created per package.
+var targets = map[string]func() enum.Generator{
+	"bitflag":       func() enum.Generator { return bitflag.NewGenerator() },
+	"catalog":       func() enum.Generator { return label.NewCatalogGenerator() },
+	"docs":          func() enum.Generator { return docs.NewGenerator() },
+	"entgo":         func() enum.Generator { return entgo.NewGenerator() },
+	"gqlgen":        func() enum.Generator { return gqlgen.NewGenerator() },
+	"json":          func() enum.Generator { return json.NewGenerator() },
+	"jsonschema":    func() enum.Generator { return jsonschema.NewGenerator() },
+	"label":         func() enum.Generator { return label.NewGenerator() },
+	"ordered":       func() enum.Generator { return ordered.NewGenerator() },
+	"protobuf":      func() enum.Generator { return protobuf.NewGenerator() },
+	"registry":      func() enum.Generator { return registrygen.NewGenerator() },
+	"sql":           func() enum.Generator { return sql.NewGenerator() },
+	"sqlddl":        func() enum.Generator { return sql.NewDDLGenerator() },
+	"stringer":      func() enum.Generator { return stringer.NewGenerator() },
+	"text":          func() enum.Generator { return text.NewGenerator() },
+	"transition":    func() enum.Generator { return transition.NewGenerator() },
+	"transitiondot": func() enum.Generator { return transition.NewDiagramGenerator() },
+	"typescript":    func() enum.Generator { return typescript.NewGenerator() },
+	"validator":     func() enum.Generator { return validator.NewGenerator() },
+}
+
+var (
+	targetList = flag.String("targets", "", "comma-separated list of generators")
+	typeList   = flag.String("type", "", "comma-separated list of enum type names to generate (default: all enums)")
+	output     = flag.String("output", "", "output filename relative to each package directory (only for a single target)")
+	templates  = flag.String("template", "", "comma-separated list of text/template files to render for each package")
+	docsFile   = flag.String("docs", "", "write a reference document of the enums in all packages (.md or .html)")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		fmt.Fprintf(os.Stderr, "\ntargets: %s\n\nflags:\n", strings.Join(targetNames(), ", "))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	names := splitList(*targetList)
+	if len(names) == 0 && *templates == "" && *docsFile == "" {
+		helper.ExitWithError(fmt.Errorf("-targets, -template or -docs must be specified"), usage)
+	}
+	for _, name := range names {
+		if _, ok := targets[name]; !ok {
+			helper.ExitWithError(fmt.Errorf("unknown target %q, available targets are %s", name, strings.Join(targetNames(), ", ")), usage)
+		}
+	}
+	if *output != "" && len(names) > 1 {
+		helper.ExitWithError(fmt.Errorf("-output cannot be used with multiple targets"), usage)
+	}
+	var templateGenerators []enum.Generator
+	for _, path := range splitList(*templates) {
+		g, err := tmpl.NewGenerator(path)
+		if err != nil {
+			helper.ExitWithError(err, "")
+		}
+		templateGenerators = append(templateGenerators, g)
+	}
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+	pkgs, err := enum.Load(".", patterns...)
+	if err != nil {
+		helper.ExitWithError(err, "")
+	}
+	types := splitList(*typeList)
+	found := make(map[string]bool)
+	for _, pkg := range pkgs {
+		pkg.Enums = filterEnums(pkg.Enums, types)
+		for _, e := range pkg.Enums {
+			found[e.Name] = true
+		}
+	}
+	for _, t := range types {
+		if !found[t] {
+			helper.ExitWithError(fmt.Errorf("enum type %q is not found", t), "")
+		}
+	}
+	var failed bool
+	for _, pkg := range pkgs {
+		if len(pkg.Enums) == 0 {
+			continue
+		}
+		var generators []enum.Generator
+		for _, name := range names {
+			g := targets[name]()
+			if name == "text" && contains(names, "stringer") {
+				// String() is written by stringer
+				g = text.NewGenerator(text.OmitString())
+			}
+			if name == "sql" && contains(names, "entgo") {
+				// Scan() and Value() must store the values listed by Values() for ent
+				storage, err := entgoStorage(pkg.Enums)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "ERROR: %s: %v\n", pkg.PkgPath, err)
+					failed = true
+					continue
+				}
+				g = sql.NewGenerator(sql.StoreBy(storage))
+			}
+			if *output != "" {
+				g = &renamed{Generator: g, filename: *output}
+			}
+			generators = append(generators, g)
+		}
+		generators = append(generators, templateGenerators...)
+		if err := pkg.Generate(generators...); err != nil {
+			// keep generating the other packages and exit with an error at last
+			fmt.Fprintf(os.Stderr, "ERROR: %v\n", err)
+			failed = true
+		}
+	}
+	if *docsFile != "" {
+		if err := writeDocs(*docsFile, pkgs); err != nil {
+			helper.ExitWithError(err, "")
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// writeDocs writes a reference document of the enums grouped by package.
+func writeDocs(filename string, pkgs []*enum.Package) error {
+	format := docs.FormatMarkdown
+	if ext := filepath.Ext(filename); ext == ".html" || ext == ".htm" {
+		format = docs.FormatHTML
+	}
+	var enums []enum.EnumType
+	for _, pkg := range pkgs {
+		enums = append(enums, pkg.Enums...)
+	}
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return docs.NewGenerator(docs.OutputFormat(format)).Generate(file, enums)
+}
+
+// entgoStorage returns the storage of enum/sql consistent with Values() generated by enum/entgo, which lists
+// the values of string enums and the names of other enums. It returns an error if both kinds are in the enums
+// as a single sql generator cannot store them differently.
+func entgoStorage(enums []enum.EnumType) (sql.Storage, error) {
+	var byValue, byName []string
+	for _, e := range enums {
+		if e.Kind == enum.EnumKindString {
+			byValue = append(byValue, e.Name)
+		} else {
+			byName = append(byName, e.Name)
+		}
+	}
+	if len(byValue) > 0 && len(byName) > 0 {
+		return "", fmt.Errorf("entgo stores %s by values and %s by names, declare them in separate packages",
+			strings.Join(byValue, ", "), strings.Join(byName, ", "))
+	}
+	if len(byName) > 0 {
+		return sql.StorageName, nil
+	}
+	return sql.StorageValue, nil
+}
+
+// renamed overrides the filename of the generator.
+type renamed struct {
+	enum.Generator
+	filename string
+}
+
+func (r *renamed) Filename() string {
+	return r.filename
+}
+
+func filterEnums(enums []enum.EnumType, types []string) []enum.EnumType {
+	if len(types) == 0 {
+		return enums
+	}
+	var list []enum.EnumType
+	for _, e := range enums {
+		for _, t := range types {
+			if e.Name == t {
+				list = append(list, e)
+				break
+			}
+		}
+	}
+	return list
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func splitList(s string) []string {
+	var list []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+func targetNames() []string {
+	var names []string
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
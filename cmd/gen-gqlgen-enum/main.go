@@ -14,7 +14,7 @@ const usage = `Usage:
 
 func main() {
 	var directories []string
-	if len(os.Args) < 1 {
+	if len(os.Args) < 2 {
 		directories = []string{"."}
 	} else {
 		directories = os.Args[1:]
@@ -12,8 +12,21 @@ import (
 	"time"
 
 	"github.com/yssk22/go-generators/enum"
+	"github.com/yssk22/go-generators/enum/bitflag"
+	"github.com/yssk22/go-generators/enum/docs"
 	"github.com/yssk22/go-generators/enum/entgo"
 	"github.com/yssk22/go-generators/enum/gqlgen"
+	enumjson "github.com/yssk22/go-generators/enum/json"
+	"github.com/yssk22/go-generators/enum/jsonschema"
+	"github.com/yssk22/go-generators/enum/label"
+	"github.com/yssk22/go-generators/enum/ordered"
+	"github.com/yssk22/go-generators/enum/registrygen"
+	enumsql "github.com/yssk22/go-generators/enum/sql"
+	"github.com/yssk22/go-generators/enum/stringer"
+	"github.com/yssk22/go-generators/enum/text"
+	"github.com/yssk22/go-generators/enum/transition"
+	"github.com/yssk22/go-generators/enum/typescript"
+	"github.com/yssk22/go-generators/enum/validator"
 	"github.com/yssk22/go-generators/graphql"
 	graphqlgqlgen "github.com/yssk22/go-generators/graphql/gqlgen"
 )
@@ -22,7 +35,7 @@ func TestE2E(t *testing.T) {
 	// cleanup gqlgen dir
 	os.RemoveAll("./testdata/e2e/gqlgen")
 	// generate enums
-	err := enum.Generate("./testdata/e2e/models", gqlgen.NewGenerator())
+	err := enum.Generate("./testdata/e2e/models", gqlgen.NewGenerator(gqlgen.ReportUnknown("reportUnknownEnum")))
 	if err != nil {
 		t.Fatalf("failed to generate enum for gqlgen: %v", err)
 	}
@@ -30,6 +43,68 @@ func TestE2E(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to generate enum for entgo: %v", err)
 	}
+	err = enum.Generate("./testdata/e2e/models", stringer.NewGenerator())
+	if err != nil {
+		t.Fatalf("failed to generate enum for stringer: %v", err)
+	}
+	err = enum.Generate("./testdata/e2e/models", enumjson.NewGenerator(enumjson.ReportUnknown("reportUnknownEnum")))
+	if err != nil {
+		t.Fatalf("failed to generate enum for json: %v", err)
+	}
+	err = enum.Generate("./testdata/e2e/models", enumsql.NewGenerator(enumsql.ReportUnknown("reportUnknownEnum")))
+	if err != nil {
+		t.Fatalf("failed to generate enum for sql: %v", err)
+	}
+	err = enum.Generate("./testdata/e2e/models", validator.NewGenerator())
+	if err != nil {
+		t.Fatalf("failed to generate enum for validator: %v", err)
+	}
+	err = enum.Generate("./testdata/e2e/models", ordered.NewGenerator())
+	if err != nil {
+		t.Fatalf("failed to generate enum for ordered: %v", err)
+	}
+	err = enum.Generate("./testdata/e2e/models", bitflag.NewGenerator())
+	if err != nil {
+		t.Fatalf("failed to generate enum for bitflag: %v", err)
+	}
+	// String() is written by stringer
+	err = enum.Generate("./testdata/e2e/models", text.NewGenerator(text.OmitString()))
+	if err != nil {
+		t.Fatalf("failed to generate enum for text: %v", err)
+	}
+	err = enum.Generate("./testdata/e2e/models", label.NewGenerator())
+	if err != nil {
+		t.Fatalf("failed to generate enum for label: %v", err)
+	}
+	err = enum.Generate("./testdata/e2e/models", typescript.NewGenerator())
+	if err != nil {
+		t.Fatalf("failed to generate enum for typescript: %v", err)
+	}
+	err = enum.Generate("./testdata/e2e/models", jsonschema.NewGenerator())
+	if err != nil {
+		t.Fatalf("failed to generate enum for jsonschema: %v", err)
+	}
+	err = enum.Generate("./testdata/e2e/models", docs.NewGenerator())
+	if err != nil {
+		t.Fatalf("failed to generate enum for docs: %v", err)
+	}
+	err = enum.Generate("./testdata/e2e/models", transition.NewGenerator(), transition.NewDiagramGenerator())
+	if err != nil {
+		t.Fatalf("failed to generate enum for transition: %v", err)
+	}
+	err = enum.Generate("./testdata/e2e/models", registrygen.NewGenerator())
+	if err != nil {
+		t.Fatalf("failed to generate enum for registry: %v", err)
+	}
+	// compile the generated enums and check their round trips
+	var testOutput bytes.Buffer
+	test := exec.Command("go", "test", "./models")
+	test.Dir = "./testdata/e2e/"
+	test.Stdout = &testOutput
+	test.Stderr = &testOutput
+	if err := test.Run(); err != nil {
+		t.Fatalf("failed to test the generated enums: %v - output: %s", err, testOutput.String())
+	}
 	err = graphql.Generate("./testdata/e2e/models", graphqlgqlgen.NewGenerator("./testdata/e2e/gqlgen"))
 	if err != nil {
 		t.Fatalf("failed to generate a server code: %v", err)
@@ -60,13 +135,15 @@ queryExample {
 	fieldString
 	fieldUserDefinedScalar
 	fieldUserDefinedEnum
+	fieldUserDefinedIntEnum
   }
 }`
 	expect := map[string]interface{}{
 		"queryExample": map[string]interface{}{
-			"fieldString":            "strValue",
-			"fieldUserDefinedScalar": "no",
-			"fieldUserDefinedEnum":   "ValueA",
+			"fieldString":             "strValue",
+			"fieldUserDefinedScalar":  "no",
+			"fieldUserDefinedEnum":    "ValueA",
+			"fieldUserDefinedIntEnum": "High",
 		},
 	}
 	requestBody, _ := json.Marshal(map[string]interface{}{
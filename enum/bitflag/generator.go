@@ -0,0 +1,117 @@
+package bitflag
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename = "bitflag_enums.go"
+)
+
+type generator struct {
+}
+
+// NewGenerator returns a generator to write set operations and String() for bit flag enums declared by `//enum:flags`.
+// Other enums are ignored.
+func NewGenerator() enum.Generator {
+	return &generator{}
+}
+
+func (g *generator) Filename() string {
+	return generatedFilename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	var flags []enum.EnumType
+	for _, e := range enums {
+		if e.Flags {
+			flags = append(flags, e)
+		}
+	}
+	if len(flags) == 0 {
+		return nil
+	}
+	fmt.Fprintf(out, "import (\n")
+	fmt.Fprintf(out, "\t\"fmt\"\n")
+	fmt.Fprintf(out, "\t\"strings\"\n")
+	fmt.Fprintf(out, ")\n")
+	fmt.Fprintf(out, "\n")
+	for _, e := range flags {
+		g.writeSetOperations(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeFlags(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeString(e, out)
+		fmt.Fprint(out, "\n")
+	}
+	return nil
+}
+
+func (g *generator) writeSetOperations(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "// Has returns true if all bits of f are set.\n")
+	fmt.Fprintf(w, "func (e %s) Has(f %s) bool {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\treturn e&f == f\n")
+	fmt.Fprintf(w, "}\n")
+	fmt.Fprint(w, "\n")
+	fmt.Fprintf(w, "// Set returns the flags with the bits of f set.\n")
+	fmt.Fprintf(w, "func (e %s) Set(f %s) %s {\n", e.Name, e.Name, e.Name)
+	fmt.Fprintf(w, "\treturn e | f\n")
+	fmt.Fprintf(w, "}\n")
+	fmt.Fprint(w, "\n")
+	fmt.Fprintf(w, "// Clear returns the flags with the bits of f cleared.\n")
+	fmt.Fprintf(w, "func (e %s) Clear(f %s) %s {\n", e.Name, e.Name, e.Name)
+	fmt.Fprintf(w, "\treturn e &^ f\n")
+	fmt.Fprintf(w, "}\n")
+	fmt.Fprint(w, "\n")
+	fmt.Fprintf(w, "// Toggle returns the flags with the bits of f toggled.\n")
+	fmt.Fprintf(w, "func (e %s) Toggle(f %s) %s {\n", e.Name, e.Name, e.Name)
+	fmt.Fprintf(w, "\treturn e ^ f\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeFlags(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "// Flags returns the declared flags set in e.\n")
+	fmt.Fprintf(w, "func (e %s) Flags() []%s {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\tvar flags []%s\n", e.Name)
+	fmt.Fprintf(w, "\tfor _, f := range []%s{\n", e.Name)
+	for _, c := range e.FlagKeys() {
+		fmt.Fprintf(w, "\t\t%s,\n", c.GoName)
+	}
+	fmt.Fprintf(w, "\t} {\n")
+	fmt.Fprintf(w, "\t\tif e&f == f {\n")
+	fmt.Fprintf(w, "\t\t\tflags = append(flags, f)\n")
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn flags\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeString(e enum.EnumType, w io.Writer) {
+	zero := "0"
+	for _, c := range e.Keys {
+		if c.Value == "0" {
+			zero = c.Name
+			break
+		}
+	}
+	fmt.Fprintf(w, "func (e %s) String() string {\n", e.Name)
+	fmt.Fprintf(w, "\tif e == 0 {\n")
+	fmt.Fprintf(w, "\t\treturn %q\n", zero)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tvar names []string\n")
+	fmt.Fprintf(w, "\trest := e\n")
+	for _, c := range e.FlagKeys() {
+		fmt.Fprintf(w, "\tif e&%s == %s {\n", c.GoName, c.GoName)
+		fmt.Fprintf(w, "\t\tnames = append(names, %q)\n", c.Name)
+		fmt.Fprintf(w, "\t\trest &^= %s\n", c.GoName)
+		fmt.Fprintf(w, "\t}\n")
+	}
+	fmt.Fprintf(w, "\tif rest != 0 {\n")
+	fmt.Fprintf(w, "\t\tnames = append(names, fmt.Sprintf(\"%s(%%d)\", rest))\n", e.Name)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn strings.Join(names, \"|\")\n")
+	fmt.Fprintf(w, "}\n")
+}
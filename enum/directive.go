@@ -0,0 +1,321 @@
+package enum
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/yssk22/go-generators/helper"
+)
+
+const (
+	directivePrefix = "enum"
+
+	// package directive to treat all named basic types with constants as enums.
+	directiveHeuristic = "heuristic"
+	// constant directive to override EnumKey.Name
+	directiveName = "name"
+	// package or type directive to specify the naming policy of EnumKey.Name
+	directiveNaming = "naming"
+	// type directive to declare bit flags like `1 << iota`
+	directiveFlags = "flags"
+	// constant directive to declare the fallback of unknown values
+	directiveUnknown = "unknown"
+	// constant directive to declare the allowed transitions like `enum:next=Shipped,Cancelled`
+	directiveNext = "next"
+	// type directive to declare the declaration order is meaningful like severities
+	directiveOrdered = "ordered"
+	// constant directive to pin the protobuf tag like `enum:proto=3`
+	directiveProto = "proto"
+	// type directive to declare the protobuf tags or key names removed from the enum like `enum:proto_reserved=2,Archived`
+	directiveProtoReserved = "proto_reserved"
+
+	// annotation for the human readable label of a constant
+	annotationLabel = "label"
+)
+
+// NamingPolicy is a policy to convert constant names to EnumKey.Name
+type NamingPolicy string
+
+const (
+	// NamingAsIs uses the constant name without the type name prefix (default)
+	NamingAsIs = NamingPolicy("as_is")
+	// NamingScreamingSnakeCase converts ValueA to VALUE_A, which is the GraphQL convention.
+	NamingScreamingSnakeCase = NamingPolicy("screaming_snake")
+)
+
+func (p NamingPolicy) isValid() bool {
+	switch p {
+	case NamingAsIs, NamingScreamingSnakeCase:
+		return true
+	}
+	return false
+}
+
+func (p NamingPolicy) apply(name string) string {
+	switch p {
+	case NamingScreamingSnakeCase:
+		return helper.ToScreamingSnakeCase(name)
+	}
+	return name
+}
+
+// directive is a comment like `//enum` or `//enum:key=value`.
+// Key is empty for the bare `//enum` marker.
+type directive struct {
+	Key   string
+	Value string
+}
+
+type directives []directive
+
+func (d directives) has(key string) bool {
+	for _, dd := range d {
+		if dd.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (d directives) get(key string) (string, bool) {
+	for _, dd := range d {
+		if dd.Key == key {
+			return dd.Value, true
+		}
+	}
+	return "", false
+}
+
+// constDoc is the documentation of a constant other than directives.
+type constDoc struct {
+	description string            // doc comment
+	meta        map[string]string // trailing annotations like `// label: "Value A" color=red`
+}
+
+// packageDirectives is a set of directives declared in a package.
+type packageDirectives struct {
+	pkg      directives
+	types    map[token.Pos]directives // keyed by the position of the type name
+	consts   map[token.Pos]directives // keyed by the position of the constant name
+	docs     map[token.Pos]constDoc   // keyed by the position of the constant name
+	typeDocs map[token.Pos]string     // keyed by the position of the type name
+}
+
+func parsePackageDirectives(files []*ast.File) *packageDirectives {
+	d := &packageDirectives{
+		types:    make(map[token.Pos]directives),
+		consts:   make(map[token.Pos]directives),
+		docs:     make(map[token.Pos]constDoc),
+		typeDocs: make(map[token.Pos]string),
+	}
+	for _, f := range files {
+		d.pkg = append(d.pkg, parseDirectives(f.Doc)...)
+		for _, decl := range f.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					doc := s.Doc
+					if doc == nil && len(genDecl.Specs) == 1 {
+						doc = genDecl.Doc
+					}
+					d.types[s.Name.Pos()] = parseDirectives(doc)
+					d.typeDocs[s.Name.Pos()] = strings.Join(commentLines(doc), " ")
+					break
+				case *ast.ValueSpec:
+					if genDecl.Tok != token.CONST {
+						continue
+					}
+					doc := s.Doc
+					if doc == nil && len(genDecl.Specs) == 1 {
+						doc = genDecl.Doc
+					}
+					dd := append(parseDirectives(doc), parseDirectives(s.Comment)...)
+					cd := parseConstDoc(doc, s.Comment)
+					for _, name := range s.Names {
+						d.consts[name.Pos()] = dd
+						d.docs[name.Pos()] = cd
+					}
+					break
+				}
+			}
+		}
+	}
+	return d
+}
+
+// isHeuristic returns true if the package is declared with `//enum:heuristic`
+func (d *packageDirectives) isHeuristic() bool {
+	return d.pkg.has(directiveHeuristic)
+}
+
+// namingPolicy returns the naming policy for the type declared by `enum:naming` on the type or the package.
+// It returns an error if the policy is unknown.
+func (d *packageDirectives) namingPolicy(pos token.Pos) (NamingPolicy, error) {
+	v, ok := d.types[pos].get(directiveNaming)
+	if !ok {
+		v, ok = d.pkg.get(directiveNaming)
+	}
+	if !ok {
+		return NamingAsIs, nil
+	}
+	if p := NamingPolicy(v); p.isValid() {
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown naming policy %q, must be %q or %q", v, NamingAsIs, NamingScreamingSnakeCase)
+}
+
+// isFlags returns true if the type is declared with `//enum:flags`
+func (d *packageDirectives) isFlags(pos token.Pos) bool {
+	return d.types[pos].has(directiveFlags)
+}
+
+// isOrdered returns true if the type is declared with `//enum:ordered`
+func (d *packageDirectives) isOrdered(pos token.Pos) bool {
+	return d.types[pos].has(directiveOrdered)
+}
+
+// protoReserved returns the protobuf tags or key names declared by `enum:proto_reserved` on the type.
+func (d *packageDirectives) protoReserved(pos token.Pos) []string {
+	v, _ := d.types[pos].get(directiveProtoReserved)
+	return splitValues(v)
+}
+
+// isMarked returns true if the type declaration has any enum directive.
+func (d *packageDirectives) isMarked(pos token.Pos) bool {
+	return len(d.types[pos]) > 0
+}
+
+// splitValues splits a comma separated directive value like `A,B`.
+func splitValues(v string) []string {
+	var list []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+func parseDirectives(cg *ast.CommentGroup) directives {
+	if cg == nil {
+		return nil
+	}
+	var list directives
+	for _, c := range cg.List {
+		if !strings.HasPrefix(c.Text, "//") {
+			continue
+		}
+		text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+		if text == directivePrefix {
+			list = append(list, directive{})
+			continue
+		}
+		if !strings.HasPrefix(text, directivePrefix+":") {
+			continue
+		}
+		for _, field := range strings.Fields(strings.TrimPrefix(text, directivePrefix+":")) {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) == 2 {
+				list = append(list, directive{Key: kv[0], Value: kv[1]})
+			} else {
+				list = append(list, directive{Key: kv[0]})
+			}
+		}
+	}
+	return list
+}
+
+// parseConstDoc reads the description from the doc comment and the annotations from the trailing comment.
+// The trailing comment is used as the description if it is not annotations and the doc comment is empty.
+func parseConstDoc(doc *ast.CommentGroup, comment *ast.CommentGroup) constDoc {
+	cd := constDoc{
+		description: strings.Join(commentLines(doc), " "),
+	}
+	for _, line := range commentLines(comment) {
+		if meta, ok := parseAnnotations(line); ok {
+			if cd.meta == nil {
+				cd.meta = make(map[string]string)
+			}
+			for k, v := range meta {
+				cd.meta[k] = v
+			}
+			continue
+		}
+		if cd.description == "" {
+			cd.description = line
+		}
+	}
+	return cd
+}
+
+// commentLines returns the lines of the comment except directives.
+func commentLines(cg *ast.CommentGroup) []string {
+	if cg == nil {
+		return nil
+	}
+	var lines []string
+	for _, c := range cg.List {
+		text := c.Text
+		if strings.HasPrefix(text, "/*") {
+			text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+		} else {
+			text = strings.TrimPrefix(text, "//")
+		}
+		for _, line := range strings.Split(text, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || line == directivePrefix || strings.HasPrefix(line, directivePrefix+":") {
+				continue
+			}
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// parseAnnotations parses `key=value` pairs separated by spaces. A value can be a quoted string.
+// Only the label can be written as `label: value` so that comments like `// TODO: remove` are not annotations.
+// It returns false if the text is not a list of annotations.
+func parseAnnotations(text string) (map[string]string, bool) {
+	meta := make(map[string]string)
+	for text = strings.TrimSpace(text); text != ""; text = strings.TrimSpace(text) {
+		i := strings.IndexAny(text, ":=")
+		if i <= 0 {
+			return nil, false
+		}
+		key := text[:i]
+		if strings.ContainsAny(key, " \t\"") {
+			return nil, false
+		}
+		if text[i] == ':' && key != annotationLabel {
+			return nil, false
+		}
+		text = strings.TrimLeft(text[i+1:], " \t")
+		var value string
+		if strings.HasPrefix(text, "\"") {
+			quoted, err := strconv.QuotedPrefix(text)
+			if err != nil {
+				return nil, false
+			}
+			value, _ = strconv.Unquote(quoted)
+			text = text[len(quoted):]
+		} else {
+			if j := strings.IndexAny(text, " \t"); j >= 0 {
+				value, text = text[:j], text[j:]
+			} else {
+				value, text = text, ""
+			}
+		}
+		if value == "" {
+			return nil, false
+		}
+		meta[key] = value
+	}
+	return meta, len(meta) > 0
+}
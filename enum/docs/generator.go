@@ -0,0 +1,168 @@
+package docs
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename     = "enums.md"
+	generatedHTMLFilename = "enums.html"
+)
+
+// Format is a format of the generated document.
+type Format string
+
+const (
+	// FormatMarkdown writes a Markdown document.
+	FormatMarkdown = Format("markdown")
+	// FormatHTML writes a standalone HTML document.
+	FormatHTML = Format("html")
+)
+
+type generator struct {
+	filename string
+	format   Format
+	title    string
+}
+
+type Option func(*generator) *generator
+
+// OutputFormat configures the document format. FormatMarkdown is used by default.
+func OutputFormat(f Format) Option {
+	return func(g *generator) *generator {
+		g.format = f
+		return g
+	}
+}
+
+// Filename configures the path of the document. The default depends on the format.
+func Filename(name string) Option {
+	return func(g *generator) *generator {
+		g.filename = name
+		return g
+	}
+}
+
+// Title configures the title of the document.
+func Title(title string) Option {
+	return func(g *generator) *generator {
+		g.title = title
+		return g
+	}
+}
+
+// NewGenerator returns a generator to write a reference document of enums grouped by package.
+// The enums of many packages can be written in one document by passing them to Generate together.
+func NewGenerator(options ...Option) enum.Generator {
+	g := &generator{
+		format: FormatMarkdown,
+		title:  "Enums",
+	}
+	for _, opts := range options {
+		g = opts(g)
+	}
+	if g.filename == "" {
+		g.filename = generatedFilename
+		if g.format == FormatHTML {
+			g.filename = generatedHTMLFilename
+		}
+	}
+	return g
+}
+
+func (g *generator) Filename() string {
+	return g.filename
+}
+
+// packageEnums is a list of enums in a package.
+type packageEnums struct {
+	PkgPath string
+	Enums   []enum.EnumType
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	var packages []*packageEnums
+	index := make(map[string]*packageEnums)
+	for _, e := range enums {
+		p, ok := index[e.PkgPath]
+		if !ok {
+			p = &packageEnums{PkgPath: e.PkgPath}
+			index[e.PkgPath] = p
+			packages = append(packages, p)
+		}
+		p.Enums = append(p.Enums, e)
+	}
+	switch g.format {
+	case FormatMarkdown:
+		g.writeMarkdown(out, packages)
+		return nil
+	case FormatHTML:
+		return htmlTemplate.Execute(out, map[string]interface{}{
+			"Title":    g.title,
+			"Packages": packages,
+		})
+	}
+	return fmt.Errorf("unknown format: %s", g.format)
+}
+
+func (g *generator) writeMarkdown(w io.Writer, packages []*packageEnums) {
+	fmt.Fprintf(w, "# %s\n", g.title)
+	for _, p := range packages {
+		fmt.Fprintf(w, "\n")
+		fmt.Fprintf(w, "## %s\n", p.PkgPath)
+		for _, e := range p.Enums {
+			fmt.Fprintf(w, "\n")
+			fmt.Fprintf(w, "### %s\n", e.Name)
+			fmt.Fprintf(w, "\n")
+			if e.Description != "" {
+				fmt.Fprintf(w, "%s\n", e.Description)
+				fmt.Fprintf(w, "\n")
+			}
+			fmt.Fprintf(w, "| Go Name | Name | Value | Description |\n")
+			fmt.Fprintf(w, "| --- | --- | --- | --- |\n")
+			for _, c := range e.Keys {
+				fmt.Fprintf(w, "| `%s` | `%s` | `%s` | %s |\n", c.GoName, c.Name, escapeMarkdownCell(c.Value), escapeMarkdownCell(c.Description))
+			}
+		}
+	}
+}
+
+func escapeMarkdownCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
+
+var htmlTemplate = template.Must(template.New("htmlTemplate").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{- range .Packages}}
+<h2>{{.PkgPath}}</h2>
+{{- range .Enums}}
+<h3 id="{{.PkgPath}}.{{.Name}}">{{.Name}}</h3>
+{{- if .Description}}
+<p>{{.Description}}</p>
+{{- end}}
+<table>
+<thead>
+<tr><th>Go Name</th><th>Name</th><th>Value</th><th>Description</th></tr>
+</thead>
+<tbody>
+{{- range .Keys}}
+<tr><td><code>{{.GoName}}</code></td><td><code>{{.Name}}</code></td><td><code>{{.Value}}</code></td><td>{{.Description}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
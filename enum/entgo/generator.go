@@ -25,6 +25,16 @@ func (g *generator) Filename() string {
 func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
 	for _, e := range enums {
 		fmt.Fprintf(out, "func (%s) Values() (types []string) {\n", e.Name)
+		if e.Kind != enum.EnumKindString {
+			// ent stores non string enums by names via ValueScanner so Values() returns names.
+			fmt.Fprintf(out, "\treturn []string{\n")
+			for _, c := range e.DistinctKeys() {
+				fmt.Fprintf(out, "\t\t%q,\n", c.Name)
+			}
+			fmt.Fprintf(out, "\t}\n")
+			fmt.Fprintf(out, "}\n")
+			continue
+		}
 		fmt.Fprintf(out, "\tfor _, r := range []%s{\n", e.Name)
 		for _, c := range e.Keys {
 			fmt.Fprintf(out, "\t\t%s,\n", c.GoName)
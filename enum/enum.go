@@ -1,11 +1,16 @@
 package enum
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/constant"
 	"go/types"
-	"log"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/yssk22/go-generators/helper"
@@ -16,6 +21,7 @@ import (
   GenEnum generates MarshalGraphQL and UnmarshalGraphQL for enum types
 
   ````
+  //enum
   type MyEnum string
   const (
 	  MyEnumValueA = MyEnum("value_a")
@@ -28,111 +34,365 @@ import (
   ValueA/ValueB to go constants MyEnumValueA/MyEnumValueB and vice versa.
 
   gqlgen-enum can be used to generate these methods so you don't have to write marshaler/unmarshaler by yourselve.
+
+  Only the types marked by `//enum` directive are enums. If the package comment has `//enum:heuristic` directive,
+  all named basic types with typed constants in the package are treated as enums.
 */
 func Generate(dir string, generators ...Generator) error {
 	importPath, err := helper.ResolveGoImportPath(dir)
 	if err != nil {
 		return err
 	}
+	pkgs, err := Load(dir, importPath)
+	if err != nil {
+		return err
+	}
+	if len(pkgs) == 0 {
+		return fmt.Errorf("no package found in %s", dir)
+	}
+	pkg := pkgs[0]
+	// write files into dir as it is given rather than the absolute package directory.
+	pkg.Dir = dir
+	return pkg.Generate(generators...)
+}
+
+// Package is a Go package with its enums.
+type Package struct {
+	Name    string
+	PkgPath string
+	Dir     string
+	Enums   []EnumType
+}
+
+// Load loads the packages matching the patterns (e.g. ./...) relative to dir and collects their enums.
+// All packages are loaded at once so that generators can be run over many packages without loading them again.
+func Load(dir string, patterns ...string) ([]*Package, error) {
 	cfg := &packages.Config{
 		Mode: packages.LoadAllSyntax,
 		Dir:  dir,
 	}
-	pkgs, err := packages.Load(cfg, importPath)
+	pkgs, err := packages.Load(cfg, patterns...)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	pkg := pkgs[0]
-	scope := pkg.Types.Scope()
-	enums := getEnumList(scope)
+	var list []*Package
+	for _, pkg := range pkgs {
+		// enums cannot be discovered correctly from a package with errors, e.g. a type error or a pattern
+		// matching no packages.
+		if len(pkg.Errors) > 0 {
+			var errs []string
+			for _, e := range pkg.Errors {
+				errs = append(errs, e.Error())
+			}
+			return nil, fmt.Errorf("%s: %s", pkg.PkgPath, strings.Join(errs, "\n"))
+		}
+		if pkg.Types == nil || len(pkg.GoFiles) == 0 {
+			continue
+		}
+		enums, err := getEnumList(pkg.Types.Scope(), pkg.Syntax)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pkg.PkgPath, err)
+		}
+		list = append(list, &Package{
+			Name:    pkg.Name,
+			PkgPath: pkg.PkgPath,
+			Dir:     filepath.Dir(pkg.GoFiles[0]),
+			Enums:   enums,
+		})
+	}
+	return list, nil
+}
+
+// Generate runs the generators against the enums in the package. A file is written only when its generator
+// succeeds so that an invalid enum never leaves a truncated file. An error in a generator does not stop
+// the other generators and the errors are returned together.
+func (p *Package) Generate(generators ...Generator) error {
+	var errs []string
 	for _, g := range generators {
+		path := OutputPath(p.Dir, g.Filename())
 		err := func(g Generator) error {
-			filename := g.Filename()
-			file, err := os.OpenFile(filepath.Join(dir, filename), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+			var buff bytes.Buffer
+			// generators may write non Go files such as .proto
+			if filepath.Ext(g.Filename()) == ".go" {
+				fmt.Fprintf(&buff, "package %s\n", p.Name)
+				fmt.Fprintf(&buff, "\n")
+			}
+			var err error
+			if dg, ok := g.(DirGenerator); ok {
+				err = dg.GenerateInDir(&buff, p.Dir, p.Enums)
+			} else {
+				err = g.Generate(&buff, p.Enums)
+			}
 			if err != nil {
 				return err
 			}
-			defer file.Close()
-			fmt.Fprintf(file, "package %s\n", pkg.Name)
-			fmt.Fprintf(file, "\n")
-			return g.Generate(file, enums)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return err
+			}
+			return os.WriteFile(path, buff.Bytes(), 0644)
 		}(g)
 		if err != nil {
-			log.Printf("cannot generate %s: %v", filepath.Join(dir, g.Filename()), err)
+			errs = append(errs, fmt.Sprintf("cannot generate %s: %v", path, err))
 		}
 	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
 	return nil
 }
 
+// OutputPath returns the path to the generated file. filename is relative to the package directory unless it is absolute
+// so that generators can write files outside of the package (e.g. ../web/src/enums.ts).
+func OutputPath(dir string, filename string) string {
+	if filepath.IsAbs(filename) {
+		return filename
+	}
+	return filepath.Join(dir, filename)
+}
+
+// EnumKind is the kind of the underlying type of the enum.
+type EnumKind string
+
+const (
+	EnumKindString  = EnumKind("string")
+	EnumKindInteger = EnumKind("integer")
+	EnumKindFloat   = EnumKind("float")
+	EnumKindBoolean = EnumKind("boolean")
+)
+
 type EnumKey struct {
-	GoName string
-	Name   string
-	Value  string
+	GoName      string
+	Name        string
+	Value       string            // Go literal of the constant value
+	Ordinal     int               // position of the value in the declaration order, shared by the keys with the same value
+	Next        []string          // names of the keys allowed to transition to, declared by `enum:next=A,B`
+	Unknown     bool              // fallback of unknown values declared by `enum:unknown`
+	ProtoTag    string            // protobuf tag declared by `enum:proto=N`, empty if not declared
+	Description string            // doc comment of the constant
+	Meta        map[string]string // trailing annotations of the constant like `// label: "Value A" color=red`
+}
+
+// Label returns the human readable label of the key given by `label` annotation, or Name if not annotated.
+func (k EnumKey) Label() string {
+	if label, ok := k.Meta[annotationLabel]; ok {
+		return label
+	}
+	return k.Name
 }
 
 type EnumType struct {
-	Name string
-	Keys []EnumKey
+	Name        string
+	PkgPath     string // import path of the package where the type is declared
+	Kind        EnumKind
+	Underlying  string // underlying basic type name such as string, int, uint8
+	Flags       bool   // bit flags declared by `//enum:flags`, only for integer enums
+	Ordered     bool   // declaration order is meaningful, declared by `//enum:ordered`
+	Description string // doc comment of the type
+	Keys        []EnumKey
+	// protobuf tags or key names removed from the enum, declared by `//enum:proto_reserved=2,Archived`
+	ProtoReserved []string
 }
 
-func getEnumList(scope *types.Scope) []EnumType {
-	nameToGQLGenEnum := make(map[*types.Named]*EnumType)
-	names := scope.Names()
-	for _, n := range names {
-		obj := scope.Lookup(n)
-		objType, ok := obj.Type().(*types.Named)
-		if !ok {
+// UnknownKey returns the key declared by `enum:unknown` to which decoders map unknown values, or nil if not declared.
+// It returns an error if more than one key is declared.
+func (e EnumType) UnknownKey() (*EnumKey, error) {
+	var unknown *EnumKey
+	for i := range e.Keys {
+		if !e.Keys[i].Unknown {
 			continue
 		}
-		_, ok = objType.Underlying().(*types.Basic)
-		if !ok {
+		if unknown != nil {
+			return nil, fmt.Errorf("%s: both %s and %s are declared as unknown", e.Name, unknown.GoName, e.Keys[i].GoName)
+		}
+		unknown = &e.Keys[i]
+	}
+	return unknown, nil
+}
+
+// FlagKeys returns the keys of single bit flags, which excludes the keys with zero value and the composite
+// keys like `PermAll = PermRead | PermWrite`.
+func (e EnumType) FlagKeys() []EnumKey {
+	var keys []EnumKey
+	for _, k := range e.DistinctKeys() {
+		if isSingleBit(k.Value) {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// FlagMask returns a Go expression of all the flags combined, like `PermRead | PermWrite`.
+// It returns "0" when the enum has no flags.
+func (e EnumType) FlagMask() string {
+	var names []string
+	for _, k := range e.FlagKeys() {
+		names = append(names, k.GoName)
+	}
+	if len(names) == 0 {
+		return "0"
+	}
+	return strings.Join(names, " | ")
+}
+
+func isSingleBit(value string) bool {
+	v, err := strconv.ParseUint(value, 10, 64)
+	return err == nil && v != 0 && v&(v-1) == 0
+}
+
+// DistinctKeys returns the keys except ones sharing the value with a preceding key
+// so that they can be used as switch cases.
+func (e EnumType) DistinctKeys() []EnumKey {
+	var keys []EnumKey
+	seen := make(map[string]bool)
+	for _, k := range e.Keys {
+		if seen[k.Value] {
+			continue
+		}
+		seen[k.Value] = true
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// getEnumList returns the enums in the scope ordered by their declarations.
+func getEnumList(scope *types.Scope, files []*ast.File) ([]EnumType, error) {
+	d := parsePackageDirectives(files)
+	var namedList []*types.Named
+	for _, n := range scope.Names() {
+		obj, ok := scope.Lookup(n).(*types.TypeName)
+		if !ok || obj.IsAlias() {
 			continue
 		}
-		if _, ok = nameToGQLGenEnum[objType]; !ok {
-			nameToGQLGenEnum[objType] = &EnumType{}
+		objType, ok := obj.Type().(*types.Named)
+		if !ok {
+			continue
 		}
-		switch ot := obj.(type) {
-		case *types.TypeName:
-			nameToGQLGenEnum[objType].Name = ot.Id()
-			break
-		case *types.Const:
-			nameToGQLGenEnum[objType].Keys = append(nameToGQLGenEnum[objType].Keys, *newEnumKey(ot, objType.Obj().Id()))
-			break
+		if _, ok = objType.Underlying().(*types.Basic); !ok {
+			continue
 		}
+		namedList = append(namedList, objType)
 	}
-	// convert map to slice
+	sort.Slice(namedList, func(i, j int) bool {
+		return namedList[i].Obj().Pos() < namedList[j].Obj().Pos()
+	})
 	var list []EnumType
-	for _, v := range nameToGQLGenEnum {
-		if len(v.Keys) > 0 {
-			list = append(list, *v)
+	for _, named := range namedList {
+		e, err := getEnum(named, d)
+		if err != nil {
+			return nil, err
+		}
+		if len(e.Keys) > 0 {
+			list = append(list, *e)
 		}
 	}
-	return list
+	return list, nil
+}
+
+// GetEnum returns Enum for the named type. Keys are ordered by their declarations.
+// files must be the syntax of the package where the type is declared so that enum directives can be read.
+// If the type is not marked by `//enum` directive, it returns Enum without any keys.
+// It returns an error if the directives of the type are invalid.
+func GetEnum(t *types.Named, files []*ast.File) (*EnumType, error) {
+	return getEnum(t, parsePackageDirectives(files))
 }
 
-// GetEnum returns Enum for the named type
-func GetEnum(t *types.Named) *EnumType {
+func getEnum(t *types.Named, d *packageDirectives) (*EnumType, error) {
 	typeName := t.Obj().Name()
 	scope := t.Obj().Pkg().Scope()
-	var keys []EnumKey
+	var consts []*types.Const
 	for _, name := range scope.Names() {
 		obj := scope.Lookup(name)
 		if obj.Type() == t {
 			if c, ok := obj.(*types.Const); ok {
-				keys = append(keys, *newEnumKey(c, typeName))
+				consts = append(consts, c)
 			}
 		}
 	}
+	sort.Slice(consts, func(i, j int) bool {
+		return consts[i].Pos() < consts[j].Pos()
+	})
+	if !d.isHeuristic() && !d.isMarked(t.Obj().Pos()) {
+		consts = nil
+	}
+	policy, err := d.namingPolicy(t.Obj().Pos())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", typeName, err)
+	}
+	var keys []EnumKey
+	ordinals := make(map[string]int)
+	names := make(map[string]EnumKey)
+	for _, c := range consts {
+		key := newEnumKey(c, typeName, policy, d.consts[c.Pos()], d.docs[c.Pos()])
+		if _, ok := ordinals[key.Value]; !ok {
+			ordinals[key.Value] = len(ordinals)
+		}
+		key.Ordinal = ordinals[key.Value]
+		// aliases of the same value may share the name but decoders cannot tell different values by the same name.
+		if prev, ok := names[key.Name]; ok && prev.Value != key.Value {
+			return nil, fmt.Errorf("%s: %s and %s have the same name %q", typeName, prev.GoName, key.GoName, key.Name)
+		}
+		names[key.Name] = *key
+		keys = append(keys, *key)
+	}
+	kind, underlying := getEnumKind(t)
+	if d.isFlags(t.Obj().Pos()) && kind != EnumKindInteger {
+		return nil, fmt.Errorf("%s: enum:flags requires an integer type, got %s", typeName, underlying)
+	}
 	return &EnumType{
-		Name: typeName,
-		Keys: keys,
+		Name:          typeName,
+		PkgPath:       t.Obj().Pkg().Path(),
+		Kind:          kind,
+		Underlying:    underlying,
+		Flags:         d.isFlags(t.Obj().Pos()),
+		Ordered:       d.isOrdered(t.Obj().Pos()),
+		Description:   d.typeDocs[t.Obj().Pos()],
+		Keys:          keys,
+		ProtoReserved: d.protoReserved(t.Obj().Pos()),
+	}, nil
+}
+
+func getEnumKind(t *types.Named) (EnumKind, string) {
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return "", ""
+	}
+	info := basic.Info()
+	switch {
+	case info&types.IsString != 0:
+		return EnumKindString, basic.Name()
+	case info&types.IsInteger != 0:
+		return EnumKindInteger, basic.Name()
+	case info&types.IsFloat != 0:
+		return EnumKindFloat, basic.Name()
+	case info&types.IsBoolean != 0:
+		return EnumKindBoolean, basic.Name()
 	}
+	return "", basic.Name()
 }
 
-func newEnumKey(c *types.Const, prefix string) *EnumKey {
+// newEnumKey returns EnumKey for the constant. The name is the constant name without the type name prefix
+// converted by the naming policy unless it is overridden by `enum:name=NAME` directive.
+func newEnumKey(c *types.Const, prefix string, policy NamingPolicy, d directives, doc constDoc) *EnumKey {
+	value := c.Val().ExactString()
+	if c.Val().Kind() == constant.Float {
+		// ExactString may return a fraction like 1/10 so use the decimal representation.
+		f, _ := constant.Float64Val(c.Val())
+		value = strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	name := policy.apply(strings.TrimPrefix(c.Id(), prefix))
+	if override, ok := d.get(directiveName); ok && override != "" {
+		name = override
+	}
+	next, _ := d.get(directiveNext)
+	protoTag, _ := d.get(directiveProto)
 	return &EnumKey{
-		Name:   strings.TrimPrefix(c.Id(), prefix),
-		GoName: c.Id(),
-		Value:  c.Val().ExactString(),
+		Name:        name,
+		GoName:      c.Id(),
+		Value:       value,
+		Next:        splitValues(next),
+		ProtoTag:    protoTag,
+		Unknown:     d.has(directiveUnknown),
+		Description: doc.description,
+		Meta:        doc.meta,
 	}
 }
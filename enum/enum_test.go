@@ -0,0 +1,543 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func typeCheck(t *testing.T, sources ...string) (*types.Package, []*ast.File) {
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, src := range sources {
+		f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+		if err != nil {
+			t.Fatalf("cannot parse source: %v", err)
+		}
+		files = append(files, f)
+	}
+	pkg, err := (&types.Config{}).Check("example.com/enums", fset, files, nil)
+	if err != nil {
+		t.Fatalf("cannot check source: %v", err)
+	}
+	return pkg, files
+}
+
+func mustGetEnumList(t *testing.T, scope *types.Scope, files []*ast.File) []EnumType {
+	list, err := getEnumList(scope, files)
+	if err != nil {
+		t.Fatalf("cannot get enums: %v", err)
+	}
+	return list
+}
+
+func mustGetEnum(t *testing.T, named *types.Named, files []*ast.File) *EnumType {
+	e, err := GetEnum(named, files)
+	if err != nil {
+		t.Fatalf("cannot get enum: %v", err)
+	}
+	return e
+}
+
+func TestEnum_getEnumList(t *testing.T) {
+	pkg, files := typeCheck(t, `package enums
+
+// Zeta is the last.
+//enum
+type Zeta string
+
+const (
+	ZetaB Zeta = "b"
+	ZetaA Zeta = "a"
+)
+
+//enum
+type Alpha int
+
+const (
+	AlphaSecond Alpha = iota + 1
+	AlphaFirst
+)
+
+type NotEnum string
+
+type NotMarked int
+
+const NotMarkedDefault NotMarked = 5
+`)
+	got := mustGetEnumList(t, pkg.Scope(), files)
+	expect := []EnumType{
+		{
+			Name:        "Zeta",
+			PkgPath:     "example.com/enums",
+			Kind:        EnumKindString,
+			Underlying:  "string",
+			Description: "Zeta is the last.",
+			Keys: []EnumKey{
+				{GoName: "ZetaB", Name: "B", Value: `"b"`},
+				{GoName: "ZetaA", Name: "A", Value: `"a"`, Ordinal: 1},
+			},
+		},
+		{
+			Name:       "Alpha",
+			PkgPath:    "example.com/enums",
+			Kind:       EnumKindInteger,
+			Underlying: "int",
+			Keys: []EnumKey{
+				{GoName: "AlphaSecond", Name: "Second", Value: "1"},
+				{GoName: "AlphaFirst", Name: "First", Value: "2", Ordinal: 1},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expect, got) {
+		t.Errorf("expected: %v, got: %v", expect, got)
+	}
+}
+
+func TestEnum_getEnumList_Heuristic(t *testing.T) {
+	pkg, files := typeCheck(t, `//enum:heuristic
+package enums
+
+type Timeout int
+
+const DefaultTimeout Timeout = 5
+`)
+	got := mustGetEnumList(t, pkg.Scope(), files)
+	if len(got) != 1 || got[0].Name != "Timeout" {
+		t.Errorf("expected: [Timeout], got: %v", got)
+	}
+}
+
+func TestEnum_GetEnum(t *testing.T) {
+	pkg, files := typeCheck(t, `package enums
+
+type Timeout int
+
+const DefaultTimeout Timeout = 5
+
+type Status string
+
+const (
+	StatusActive Status = "active" //enum
+)
+
+type (
+	// Color is a color
+	//
+	//enum
+	Color string
+	Size  string
+)
+
+const (
+	ColorRed Color = "red"
+	SizeS    Size  = "s"
+)
+`)
+	cases := []struct {
+		name string
+		keys int
+	}{
+		{name: "Timeout", keys: 0},
+		{name: "Status", keys: 0},
+		{name: "Color", keys: 1},
+		{name: "Size", keys: 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			named := pkg.Scope().Lookup(c.name).Type().(*types.Named)
+			got := mustGetEnum(tt, named, files)
+			if len(got.Keys) != c.keys {
+				tt.Errorf("expected: %d keys, got: %v", c.keys, got.Keys)
+			}
+		})
+	}
+}
+
+func TestEnum_GetEnum_NameOverride(t *testing.T) {
+	pkg, files := typeCheck(t, `package enums
+
+//enum
+type MyEnum string
+
+const (
+	MyEnumValueA MyEnum = "value_a" // enum:name=ALPHA
+	// enum:name=BETA
+	MyEnumValueB MyEnum = "value_b"
+	MyEnumValueC MyEnum = "value_c"
+	OtherValue   MyEnum = "other"
+)
+`)
+	named := pkg.Scope().Lookup("MyEnum").Type().(*types.Named)
+	got := mustGetEnum(t, named, files)
+	var names []string
+	for _, k := range got.Keys {
+		names = append(names, k.Name)
+	}
+	expect := []string{"ALPHA", "BETA", "ValueC", "OtherValue"}
+	if !reflect.DeepEqual(expect, names) {
+		t.Errorf("expected: %v, got: %v", expect, names)
+	}
+}
+
+func TestEnum_GetEnum_DuplicateName(t *testing.T) {
+	pkg, files := typeCheck(t, `package enums
+
+//enum
+type MyEnum string
+
+const (
+	MyEnumValueA MyEnum = "value_a" // enum:name=ValueB
+	MyEnumValueB MyEnum = "value_b"
+)
+
+//enum
+type Alias string
+
+const (
+	AliasValueA Alias = "value_a"
+	AliasValueB Alias = "value_a" // enum:name=ValueA
+)
+`)
+	named := pkg.Scope().Lookup("MyEnum").Type().(*types.Named)
+	if _, err := GetEnum(named, files); err == nil {
+		t.Errorf("expected an error for the duplicate name")
+	}
+	// the keys of the same value can share the name
+	named = pkg.Scope().Lookup("Alias").Type().(*types.Named)
+	if _, err := GetEnum(named, files); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestEnum_GetEnum_Naming(t *testing.T) {
+	pkg, files := typeCheck(t, `//enum:naming=screaming_snake
+package enums
+
+//enum
+type Color string
+
+const (
+	ColorDarkRed Color = "dark_red"
+	ColorBlue    Color = "blue" // enum:name=Navy
+)
+
+//enum:naming=as_is
+type Size string
+
+const (
+	SizeExtraLarge Size = "xl"
+)
+`)
+	cases := []struct {
+		name  string
+		names []string
+	}{
+		{name: "Color", names: []string{"DARK_RED", "Navy"}},
+		{name: "Size", names: []string{"ExtraLarge"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			named := pkg.Scope().Lookup(c.name).Type().(*types.Named)
+			var names []string
+			for _, k := range mustGetEnum(tt, named, files).Keys {
+				names = append(names, k.Name)
+			}
+			if !reflect.DeepEqual(c.names, names) {
+				tt.Errorf("expected: %v, got: %v", c.names, names)
+			}
+		})
+	}
+}
+
+func TestEnum_GetEnum_UnknownNaming(t *testing.T) {
+	pkg, files := typeCheck(t, `package enums
+
+//enum:naming=camel
+type Color string
+
+const (
+	ColorDarkRed Color = "dark_red"
+)
+`)
+	named := pkg.Scope().Lookup("Color").Type().(*types.Named)
+	if _, err := GetEnum(named, files); err == nil {
+		t.Errorf("expected an error for the unknown naming policy")
+	}
+}
+
+func TestEnum_GetEnum_Flags(t *testing.T) {
+	pkg, files := typeCheck(t, `package enums
+
+//enum:flags
+type Perm int
+
+const (
+	PermNone Perm = 0
+	PermRead Perm = 1 << (iota - 1)
+	PermWrite
+	PermAll = PermRead | PermWrite
+)
+
+//enum:flags
+type Mode string
+
+const (
+	ModeA Mode = "a"
+)
+`)
+	cases := []struct {
+		name  string
+		flags bool
+		names []string
+		err   bool
+	}{
+		{name: "Perm", flags: true, names: []string{"Read", "Write"}},
+		{name: "Mode", err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			named := pkg.Scope().Lookup(c.name).Type().(*types.Named)
+			e, err := GetEnum(named, files)
+			if c.err {
+				if err == nil {
+					tt.Errorf("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if e.Flags != c.flags {
+				tt.Errorf("expected: %v, got: %v", c.flags, e.Flags)
+			}
+			var names []string
+			for _, k := range e.FlagKeys() {
+				names = append(names, k.Name)
+			}
+			if !reflect.DeepEqual(c.names, names) {
+				tt.Errorf("expected: %v, got: %v", c.names, names)
+			}
+		})
+	}
+}
+
+func TestEnum_GetEnum_Doc(t *testing.T) {
+	pkg, files := typeCheck(t, `package enums
+
+//enum
+type MyEnum string
+
+const (
+	// MyEnumValueA is the first value.
+	// enum:name=ALPHA
+	MyEnumValueA MyEnum = "value_a" // label: "Value A" color=red
+	MyEnumValueB MyEnum = "value_b" // the second value
+	MyEnumValueC MyEnum = "value_c"
+	MyEnumValueD MyEnum = "value_d" // TODO: remove
+	MyEnumValueE MyEnum = "value_e" // color=blue
+)
+`)
+	named := pkg.Scope().Lookup("MyEnum").Type().(*types.Named)
+	got := mustGetEnum(t, named, files)
+	cases := []struct {
+		name        string
+		label       string
+		description string
+		meta        map[string]string
+	}{
+		{name: "ALPHA", label: "Value A", description: "MyEnumValueA is the first value.", meta: map[string]string{"label": "Value A", "color": "red"}},
+		{name: "ValueB", label: "ValueB", description: "the second value"},
+		{name: "ValueC", label: "ValueC"},
+		{name: "ValueD", label: "ValueD", description: "TODO: remove"},
+		{name: "ValueE", label: "ValueE", meta: map[string]string{"color": "blue"}},
+	}
+	for i, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			k := got.Keys[i]
+			if k.Name != c.name {
+				tt.Errorf("expected: %v, got: %v", c.name, k.Name)
+			}
+			if k.Label() != c.label {
+				tt.Errorf("expected: %v, got: %v", c.label, k.Label())
+			}
+			if k.Description != c.description {
+				tt.Errorf("expected: %v, got: %v", c.description, k.Description)
+			}
+			if !reflect.DeepEqual(k.Meta, c.meta) {
+				tt.Errorf("expected: %v, got: %v", c.meta, k.Meta)
+			}
+		})
+	}
+}
+
+func TestEnum_GetEnum_Ordered(t *testing.T) {
+	pkg, files := typeCheck(t, `package enums
+
+//enum:ordered
+type Severity int
+
+const (
+	SeverityCritical Severity = 3
+	SeverityHigh     Severity = 2
+	SeverityUrgent   Severity = 3
+	SeverityLow      Severity = 1
+)
+`)
+	named := pkg.Scope().Lookup("Severity").Type().(*types.Named)
+	got := mustGetEnum(t, named, files)
+	if !got.Ordered {
+		t.Errorf("expected: %v, got: %v", true, got.Ordered)
+	}
+	var ordinals []int
+	for _, k := range got.Keys {
+		ordinals = append(ordinals, k.Ordinal)
+	}
+	expect := []int{0, 1, 0, 2}
+	if !reflect.DeepEqual(expect, ordinals) {
+		t.Errorf("expected: %v, got: %v", expect, ordinals)
+	}
+}
+
+func TestEnum_GetEnum_Proto(t *testing.T) {
+	pkg, files := typeCheck(t, `package enums
+
+//enum:proto_reserved=2,Archived
+type Status string
+
+const (
+	StatusActive   Status = "active"
+	StatusDisabled Status = "disabled" // enum:proto=3
+)
+`)
+	named := pkg.Scope().Lookup("Status").Type().(*types.Named)
+	got := mustGetEnum(t, named, files)
+	if expect := []string{"2", "Archived"}; !reflect.DeepEqual(expect, got.ProtoReserved) {
+		t.Errorf("expected: %v, got: %v", expect, got.ProtoReserved)
+	}
+	var tags []string
+	for _, k := range got.Keys {
+		tags = append(tags, k.ProtoTag)
+	}
+	if expect := []string{"", "3"}; !reflect.DeepEqual(expect, tags) {
+		t.Errorf("expected: %v, got: %v", expect, tags)
+	}
+}
+
+func TestEnum_GetEnum_Unknown(t *testing.T) {
+	pkg, files := typeCheck(t, `package enums
+
+//enum
+type Status string
+
+const (
+	StatusUnknown Status = "" // enum:unknown
+	StatusActive  Status = "active"
+)
+
+//enum
+type Invalid int
+
+const (
+	InvalidA Invalid = iota // enum:unknown
+	InvalidB                // enum:unknown
+)
+
+//enum
+type NoUnknown int
+
+const (
+	NoUnknownA NoUnknown = iota
+)
+`)
+	cases := []struct {
+		name    string
+		unknown string
+		err     bool
+	}{
+		{name: "Status", unknown: "StatusUnknown"},
+		{name: "Invalid", err: true},
+		{name: "NoUnknown"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			named := pkg.Scope().Lookup(c.name).Type().(*types.Named)
+			k, err := mustGetEnum(tt, named, files).UnknownKey()
+			if c.err {
+				if err == nil {
+					tt.Errorf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			var got string
+			if k != nil {
+				got = k.GoName
+			}
+			if got != c.unknown {
+				tt.Errorf("expected: %v, got: %v", c.unknown, got)
+			}
+		})
+	}
+}
+
+type fakeGenerator struct {
+	filename string
+	err      error
+}
+
+func (g *fakeGenerator) Filename() string {
+	return g.filename
+}
+
+func (g *fakeGenerator) Generate(w io.Writer, enums []EnumType) error {
+	fmt.Fprintf(w, "// %d enums\n", len(enums))
+	return g.err
+}
+
+func TestLoad_Errors(t *testing.T) {
+	if _, err := Load(".", "./nonexistent"); err == nil {
+		t.Errorf("expected an error for a pattern matching no package")
+	}
+}
+
+func TestPackage_Generate(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "a_enums.go"), []byte("package enums\n"), 0644); err != nil {
+		t.Fatalf("cannot write a_enums.go: %v", err)
+	}
+	pkg := &Package{Name: "enums", Dir: dir, Enums: []EnumType{{Name: "Status"}}}
+	err := pkg.Generate(
+		&fakeGenerator{filename: "a_enums.go", err: errors.New("broken")},
+		&fakeGenerator{filename: "b_enums.go"},
+	)
+	if err == nil || !strings.Contains(err.Error(), "broken") {
+		t.Errorf("expected: an error of a_enums.go, got: %v", err)
+	}
+	// the file of the failed generator is kept as it was.
+	a, err := os.ReadFile(filepath.Join(dir, "a_enums.go"))
+	if err != nil {
+		t.Fatalf("cannot read a_enums.go: %v", err)
+	}
+	if expected := "package enums\n"; string(a) != expected {
+		t.Errorf("expected: %q, got: %q", expected, string(a))
+	}
+	b, err := os.ReadFile(filepath.Join(dir, "b_enums.go"))
+	if err != nil {
+		t.Fatalf("cannot read b_enums.go: %v", err)
+	}
+	if expected := "package enums\n\n// 1 enums\n"; string(b) != expected {
+		t.Errorf("expected: %q, got: %q", expected, string(b))
+	}
+}
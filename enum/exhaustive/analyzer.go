@@ -0,0 +1,211 @@
+// Package exhaustive provides an analyzer to report switch statements and map literals which do not cover
+// all keys of enums discovered in the same way as enum.GetEnum.
+package exhaustive
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/yssk22/go-generators/enum"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const doc = `check that switch statements and map literals cover all keys of enums
+
+Enums are the types marked by the enum directive (see enum.GetEnum). A switch statement
+over an enum must have a case for every key unless it has a default clause, and a map
+literal keyed by an enum must have every key. Bit flag enums are not checked.`
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "enumexhaustive",
+	Doc:       doc,
+	Run:       run,
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	FactTypes: []analysis.Fact{new(enumFact)},
+}
+
+var requireDefault bool
+
+func init() {
+	Analyzer.Flags.BoolVar(&requireDefault, "require-default", false, "report switch statements over enums without default clause even if they cover all keys")
+}
+
+// enumFact is exported for the enum types so that enums in imported packages can be checked.
+type enumFact struct {
+	Keys []string // Go constant names of the distinct keys in the declaration order
+}
+
+func (*enumFact) AFact() {}
+
+func (f *enumFact) String() string {
+	return fmt.Sprintf("enum(%s)", strings.Join(f.Keys, ", "))
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	exportEnumFacts(pass)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	nodeFilter := []ast.Node{
+		(*ast.SwitchStmt)(nil),
+		(*ast.CompositeLit)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		switch node := n.(type) {
+		case *ast.SwitchStmt:
+			checkSwitch(pass, node)
+			break
+		case *ast.CompositeLit:
+			checkMapLiteral(pass, node)
+			break
+		}
+	})
+	return nil, nil
+}
+
+func exportEnumFacts(pass *analysis.Pass) {
+	scope := pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || obj.IsAlias() {
+			continue
+		}
+		named, ok := obj.Type().(*types.Named)
+		if !ok {
+			continue
+		}
+		if _, ok := named.Underlying().(*types.Basic); !ok {
+			continue
+		}
+		e, err := enum.GetEnum(named, pass.Files)
+		if err != nil {
+			// invalid enums are reported by the generators
+			continue
+		}
+		if len(e.Keys) == 0 || e.Flags {
+			continue
+		}
+		fact := &enumFact{}
+		for _, k := range e.DistinctKeys() {
+			fact.Keys = append(fact.Keys, k.GoName)
+		}
+		pass.ExportObjectFact(obj, fact)
+	}
+}
+
+// enumKey is a key of the enum with its constant value.
+type enumKey struct {
+	name  string
+	value constant.Value
+}
+
+// getEnumKeys returns the keys of the enum type, or nil if t is not an enum.
+func getEnumKeys(pass *analysis.Pass, t types.Type) (*types.Named, []enumKey) {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil, nil
+	}
+	fact := &enumFact{}
+	if !pass.ImportObjectFact(named.Obj(), fact) {
+		return nil, nil
+	}
+	scope := named.Obj().Pkg().Scope()
+	var keys []enumKey
+	for _, name := range fact.Keys {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok {
+			continue
+		}
+		keys = append(keys, enumKey{name: name, value: c.Val()})
+	}
+	return named, keys
+}
+
+func checkSwitch(pass *analysis.Pass, stmt *ast.SwitchStmt) {
+	if stmt.Tag == nil {
+		return
+	}
+	named, keys := getEnumKeys(pass, pass.TypesInfo.TypeOf(stmt.Tag))
+	if len(keys) == 0 {
+		return
+	}
+	var values []constant.Value
+	hasDefault := false
+	for _, s := range stmt.Body.List {
+		clause := s.(*ast.CaseClause)
+		if clause.List == nil {
+			hasDefault = true
+			continue
+		}
+		for _, expr := range clause.List {
+			if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil {
+				values = append(values, tv.Value)
+			}
+		}
+	}
+	missing := missingKeys(keys, values)
+	if len(missing) > 0 && !hasDefault {
+		pass.Reportf(stmt.Pos(), "missing cases in switch of type %s: %s", typeName(pass, named), strings.Join(missing, ", "))
+		return
+	}
+	if requireDefault && !hasDefault {
+		pass.Reportf(stmt.Pos(), "missing default case in switch of type %s", typeName(pass, named))
+	}
+}
+
+func checkMapLiteral(pass *analysis.Pass, lit *ast.CompositeLit) {
+	m, ok := pass.TypesInfo.TypeOf(lit).Underlying().(*types.Map)
+	if !ok {
+		return
+	}
+	if len(lit.Elts) == 0 {
+		// an empty map like `map[Status]int{}` is filled later
+		return
+	}
+	named, keys := getEnumKeys(pass, m.Key())
+	if len(keys) == 0 {
+		return
+	}
+	var values []constant.Value
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return
+		}
+		tv, ok := pass.TypesInfo.Types[kv.Key]
+		if !ok || tv.Value == nil {
+			// the keys cannot be determined statically
+			return
+		}
+		values = append(values, tv.Value)
+	}
+	missing := missingKeys(keys, values)
+	if len(missing) > 0 {
+		pass.Reportf(lit.Pos(), "missing keys in map of type %s: %s", typeName(pass, named), strings.Join(missing, ", "))
+	}
+}
+
+func missingKeys(keys []enumKey, values []constant.Value) []string {
+	var missing []string
+	for _, k := range keys {
+		found := false
+		for _, v := range values {
+			if constant.Compare(k.value, token.EQL, v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, k.name)
+		}
+	}
+	return missing
+}
+
+func typeName(pass *analysis.Pass, named *types.Named) string {
+	return types.TypeString(named, types.RelativeTo(pass.Pkg))
+}
@@ -0,0 +1,43 @@
+package a
+
+//enum
+type Status string // want Status:"enum\\(StatusActive, StatusInactive, StatusDisabled\\)"
+
+const (
+	StatusActive   Status = "active"
+	StatusInactive Status = "inactive"
+	StatusDisabled Status = "disabled"
+	StatusDefault  Status = "active"
+)
+
+// Timeout is not an enum.
+type Timeout int
+
+const DefaultTimeout Timeout = 5
+
+func Switch(s Status) string {
+	switch s { // want "missing cases in switch of type Status: StatusDisabled"
+	case StatusActive:
+		return "a"
+	case StatusInactive:
+		return "i"
+	}
+	switch s {
+	case StatusActive, StatusInactive, StatusDisabled:
+		return "all"
+	}
+	switch s {
+	case StatusActive:
+		return "a"
+	default:
+		return "default"
+	}
+}
+
+func NotEnum(t Timeout) bool {
+	switch t {
+	case DefaultTimeout:
+		return true
+	}
+	return false
+}
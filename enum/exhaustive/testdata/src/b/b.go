@@ -0,0 +1,27 @@
+package b
+
+import "a"
+
+var labels = map[a.Status]string{ // want "missing keys in map of type a.Status: StatusInactive, StatusDisabled"
+	a.StatusActive: "Active",
+}
+
+var all = map[a.Status]string{
+	a.StatusActive:   "Active",
+	a.StatusInactive: "Inactive",
+	a.StatusDisabled: "Disabled",
+}
+
+var counts = map[a.Status]int{}
+
+func Switch(s a.Status) bool {
+	switch s { // want "missing cases in switch of type a.Status: StatusActive"
+	case a.StatusInactive, a.StatusDisabled:
+		return false
+	}
+	return true
+}
+
+func Dynamic(s a.Status) map[a.Status]bool {
+	return map[a.Status]bool{s: true}
+}
@@ -2,7 +2,16 @@ package enum
 
 import "io"
 
+// Generator writes a file from enums. Filename is relative to the package directory unless it is absolute.
+// If the file name has .go extension, the package clause is written before Generate is called.
 type Generator interface {
 	Filename() string
 	Generate(io.Writer, []EnumType) error
 }
+
+// DirGenerator is a Generator which reads files in the package directory such as the previous output.
+// Package.Generate calls GenerateInDir with the package directory instead of Generate.
+type DirGenerator interface {
+	Generator
+	GenerateInDir(out io.Writer, dir string, enums []EnumType) error
+}
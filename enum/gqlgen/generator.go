@@ -12,10 +12,27 @@ const (
 )
 
 type generator struct {
+	reportUnknown string
 }
 
-func NewGenerator() enum.Generator {
-	return &generator{}
+type Option func(*generator) *generator
+
+// ReportUnknown configures the name of the function called with the type name and the raw value
+// when an unknown value is mapped to the key declared by `enum:unknown`. The function must be declared
+// in the package as `func(typeName string, raw interface{})`.
+func ReportUnknown(funcName string) Option {
+	return func(g *generator) *generator {
+		g.reportUnknown = funcName
+		return g
+	}
+}
+
+func NewGenerator(options ...Option) enum.Generator {
+	g := &generator{}
+	for _, opts := range options {
+		g = opts(g)
+	}
+	return g
 }
 
 func (g *generator) Filename() string {
@@ -23,16 +40,37 @@ func (g *generator) Filename() string {
 }
 
 func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	var hasFlags bool
+	for _, e := range enums {
+		if e.Flags {
+			hasFlags = true
+			break
+		}
+	}
 	fmt.Fprintf(out, "import (\n")
 	fmt.Fprintf(out, "\t\"fmt\"\n")
 	fmt.Fprintf(out, "\t\"io\"\n")
 	fmt.Fprintf(out, "\t\"strconv\"\n")
+	if hasFlags {
+		fmt.Fprintf(out, "\t\"strings\"\n")
+	}
 	fmt.Fprintf(out, ")\n")
 	fmt.Fprintf(out, "\n")
 	for _, e := range enums {
+		unknown, err := e.UnknownKey()
+		if err != nil {
+			return err
+		}
+		if e.Flags {
+			g.writeMarshalGraphQLFlags(e, out)
+			fmt.Fprint(out, "\n")
+			g.writeUnmarshalGraphQLFlags(e, unknown, out)
+			fmt.Fprint(out, "\n")
+			continue
+		}
 		g.writeMarshalGraphQL(e, out)
 		fmt.Fprint(out, "\n")
-		g.writeUnmarshalGraphQL(e, out)
+		g.writeUnmarshalGraphQL(e, unknown, out)
 		fmt.Fprint(out, "\n")
 	}
 	return nil
@@ -41,24 +79,87 @@ func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
 func (g *generator) writeMarshalGraphQL(e enum.EnumType, w io.Writer) {
 	fmt.Fprintf(w, "func (e %s) MarshalGQL(w io.Writer) {\n", e.Name)
 	fmt.Fprintf(w, "\tswitch e {\n")
-	for _, c := range e.Keys {
+	for _, c := range e.DistinctKeys() {
 		fmt.Fprintf(w, "\tcase %s:\n", c.GoName)
-		fmt.Fprintf(w, "\t\tfmt.Fprintf(w, strconv.Quote(%q))\n", c.Name)
-		fmt.Fprintf(w, "\t\tbreak\n")
+		fmt.Fprintf(w, "\t\tfmt.Fprint(w, strconv.Quote(%q))\n", c.Name)
+		fmt.Fprintf(w, "\t\treturn\n")
 	}
 	fmt.Fprintf(w, "\t}\n")
+	// MarshalGQL cannot return an error so write null to keep the response a valid JSON.
+	fmt.Fprintf(w, "\tfmt.Fprint(w, \"null\")\n")
 	fmt.Fprintf(w, "}\n")
 }
 
-func (g *generator) writeUnmarshalGraphQL(e enum.EnumType, w io.Writer) {
+func (g *generator) writeUnmarshalGraphQL(e enum.EnumType, unknown *enum.EnumKey, w io.Writer) {
 	fmt.Fprintf(w, "func (e *%s) UnmarshalGQL(v interface{}) error {\n", e.Name)
-	fmt.Fprintf(w, "\tswitch v.(string) {\n")
+	fmt.Fprintf(w, "\ts, ok := v.(string)\n")
+	fmt.Fprintf(w, "\tif !ok {\n")
+	fmt.Fprintf(w, "\t\treturn fmt.Errorf(\"%s must be a string, got %%T\", v)\n", e.Name)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tswitch s {\n")
 	for _, c := range e.Keys {
 		fmt.Fprintf(w, "\tcase %q:\n", c.Name)
 		fmt.Fprintf(w, "\t\t*e = %s\n", c.GoName)
-		fmt.Fprintf(w, "\t\tbreak\n")
+		fmt.Fprintf(w, "\t\treturn nil\n")
+	}
+	fmt.Fprintf(w, "\t}\n")
+	if unknown != nil {
+		fmt.Fprintf(w, "\t*e = %s\n", unknown.GoName)
+		if g.reportUnknown != "" {
+			fmt.Fprintf(w, "\t%s(%q, s)\n", g.reportUnknown, e.Name)
+		}
+		fmt.Fprintf(w, "\treturn nil\n")
+		fmt.Fprintf(w, "}\n")
+		return
+	}
+	fmt.Fprintf(w, "\treturn fmt.Errorf(\"%%q is not a valid %s\", s)\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+}
+
+// writeMarshalGraphQLFlags writes MarshalGQL for bit flags, which writes the flags set in e as a list.
+// The zero value is written as an empty list as it is not a flag.
+func (g *generator) writeMarshalGraphQLFlags(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "func (e %s) MarshalGQL(w io.Writer) {\n", e.Name)
+	fmt.Fprintf(w, "\tvar names []string\n")
+	for _, c := range e.FlagKeys() {
+		fmt.Fprintf(w, "\tif e&%s == %s {\n", c.GoName, c.GoName)
+		fmt.Fprintf(w, "\t\tnames = append(names, strconv.Quote(%q))\n", c.Name)
+		fmt.Fprintf(w, "\t}\n")
+	}
+	fmt.Fprintf(w, "\tfmt.Fprint(w, \"[\"+strings.Join(names, \",\")+\"]\")\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+// writeUnmarshalGraphQLFlags writes UnmarshalGQL for bit flags, which sets e to the flags in the given list.
+func (g *generator) writeUnmarshalGraphQLFlags(e enum.EnumType, unknown *enum.EnumKey, w io.Writer) {
+	fmt.Fprintf(w, "func (e *%s) UnmarshalGQL(v interface{}) error {\n", e.Name)
+	fmt.Fprintf(w, "\tlist, ok := v.([]interface{})\n")
+	fmt.Fprintf(w, "\tif !ok {\n")
+	fmt.Fprintf(w, "\t\treturn fmt.Errorf(\"%s must be a list, got %%T\", v)\n", e.Name)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tvar flags %s\n", e.Name)
+	fmt.Fprintf(w, "\tfor _, item := range list {\n")
+	fmt.Fprintf(w, "\t\ts, ok := item.(string)\n")
+	fmt.Fprintf(w, "\t\tif !ok {\n")
+	fmt.Fprintf(w, "\t\t\treturn fmt.Errorf(\"%s must be a list of strings, got %%T in the list\", item)\n", e.Name)
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t\tswitch s {\n")
+	for _, c := range e.FlagKeys() {
+		fmt.Fprintf(w, "\t\tcase %q:\n", c.Name)
+		fmt.Fprintf(w, "\t\t\tflags |= %s\n", c.GoName)
+	}
+	fmt.Fprintf(w, "\t\tdefault:\n")
+	if unknown != nil {
+		fmt.Fprintf(w, "\t\t\tflags |= %s\n", unknown.GoName)
+		if g.reportUnknown != "" {
+			fmt.Fprintf(w, "\t\t\t%s(%q, s)\n", g.reportUnknown, e.Name)
+		}
+	} else {
+		fmt.Fprintf(w, "\t\t\treturn fmt.Errorf(\"%%q is not a valid %s\", s)\n", e.Name)
 	}
+	fmt.Fprintf(w, "\t\t}\n")
 	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\t*e = flags\n")
 	fmt.Fprintf(w, "\treturn nil\n")
 	fmt.Fprintf(w, "}\n")
 }
@@ -0,0 +1,275 @@
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename = "json_enums.go"
+)
+
+// Encoding specifies which representation of the enum key is used in JSON.
+type Encoding string
+
+const (
+	// EncodingName uses EnumKey.Name, which is the same as the GraphQL enum value.
+	EncodingName = Encoding("name")
+	// EncodingValue uses the value of the Go constant.
+	EncodingValue = Encoding("value")
+)
+
+type generator struct {
+	encoding      Encoding
+	reportUnknown string
+}
+
+type Option func(*generator) *generator
+
+// EncodeBy configures the JSON representation of enums. EncodingName is used by default.
+func EncodeBy(e Encoding) Option {
+	return func(g *generator) *generator {
+		g.encoding = e
+		return g
+	}
+}
+
+// ReportUnknown configures the name of the function called with the type name and the raw value
+// when an unknown value is mapped to the key declared by `enum:unknown`. The function must be declared
+// in the package as `func(typeName string, raw interface{})`.
+func ReportUnknown(funcName string) Option {
+	return func(g *generator) *generator {
+		g.reportUnknown = funcName
+		return g
+	}
+}
+
+func NewGenerator(options ...Option) enum.Generator {
+	g := &generator{
+		encoding: EncodingName,
+	}
+	for _, opts := range options {
+		g = opts(g)
+	}
+	return g
+}
+
+func (g *generator) Filename() string {
+	return generatedFilename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	fmt.Fprintf(out, "import (\n")
+	fmt.Fprintf(out, "\t\"encoding/json\"\n")
+	fmt.Fprintf(out, "\t\"fmt\"\n")
+	fmt.Fprintf(out, ")\n")
+	fmt.Fprintf(out, "\n")
+	fmt.Fprintf(out, "// EnumJSONError is returned when the enum value cannot be converted from/to JSON.\n")
+	fmt.Fprintf(out, "type EnumJSONError struct {\n")
+	fmt.Fprintf(out, "\tType  string\n")
+	fmt.Fprintf(out, "\tValue string\n")
+	fmt.Fprintf(out, "}\n")
+	fmt.Fprintf(out, "\n")
+	fmt.Fprintf(out, "func (e *EnumJSONError) Error() string {\n")
+	fmt.Fprintf(out, "\treturn fmt.Sprintf(\"invalid %%s value: %%s\", e.Type, e.Value)\n")
+	fmt.Fprintf(out, "}\n")
+	fmt.Fprintf(out, "\n")
+	for _, e := range enums {
+		if g.encoding == EncodingValue && e.Kind == enum.EnumKindBoolean {
+			return fmt.Errorf("%s: %s enum cannot be encoded by value", e.Name, e.Kind)
+		}
+		unknown, err := e.UnknownKey()
+		if err != nil {
+			return err
+		}
+		if e.Flags {
+			g.writeMarshalJSONFlags(e, out)
+			fmt.Fprint(out, "\n")
+			g.writeUnmarshalJSONFlags(e, unknown, out)
+			fmt.Fprint(out, "\n")
+			continue
+		}
+		if err := g.writeMarshalJSON(e, out); err != nil {
+			return err
+		}
+		fmt.Fprint(out, "\n")
+		if err := g.writeUnmarshalJSON(e, unknown, out); err != nil {
+			return err
+		}
+		fmt.Fprint(out, "\n")
+	}
+	return nil
+}
+
+func (g *generator) writeMarshalJSON(e enum.EnumType, w io.Writer) error {
+	fmt.Fprintf(w, "func (e %s) MarshalJSON() ([]byte, error) {\n", e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, c := range e.DistinctKeys() {
+		token, err := g.jsonToken(e, c)
+		if err != nil {
+			return fmt.Errorf("cannot encode %s: %w", c.GoName, err)
+		}
+		fmt.Fprintf(w, "\tcase %s:\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn []byte(%q), nil\n", token)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn nil, &EnumJSONError{Type: %q, Value: fmt.Sprintf(\"%%#v\", e)}\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+	return nil
+}
+
+func (g *generator) writeUnmarshalJSON(e enum.EnumType, unknown *enum.EnumKey, w io.Writer) error {
+	fmt.Fprintf(w, "func (e *%s) UnmarshalJSON(b []byte) error {\n", e.Name)
+	g.writeUnmarshalJSONNull(w)
+	if g.isNumber(e) {
+		fmt.Fprintf(w, "\tvar v json.Number\n")
+	} else {
+		fmt.Fprintf(w, "\tvar v string\n")
+	}
+	fmt.Fprintf(w, "\tif err := json.Unmarshal(b, &v); err != nil {\n")
+	fmt.Fprintf(w, "\t\treturn err\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tswitch string(v) {\n")
+	seen := make(map[string]bool)
+	for _, c := range e.Keys {
+		token, err := g.jsonToken(e, c)
+		if err != nil {
+			return fmt.Errorf("cannot encode %s: %w", c.GoName, err)
+		}
+		if !g.isNumber(e) {
+			token, _ = strconv.Unquote(token)
+		}
+		if seen[token] {
+			continue
+		}
+		seen[token] = true
+		fmt.Fprintf(w, "\tcase %q:\n", token)
+		fmt.Fprintf(w, "\t\t*e = %s\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn nil\n")
+	}
+	fmt.Fprintf(w, "\t}\n")
+	if unknown != nil {
+		fmt.Fprintf(w, "\t*e = %s\n", unknown.GoName)
+		if g.reportUnknown != "" {
+			fmt.Fprintf(w, "\t%s(%q, string(v))\n", g.reportUnknown, e.Name)
+		}
+		fmt.Fprintf(w, "\treturn nil\n")
+		fmt.Fprintf(w, "}\n")
+		return nil
+	}
+	fmt.Fprintf(w, "\treturn &EnumJSONError{Type: %q, Value: string(v)}\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+	return nil
+}
+
+// writeMarshalJSONFlags writes MarshalJSON for bit flags. Any combination of the flags is encoded
+// as a list of the names, or as the number with EncodingValue.
+func (g *generator) writeMarshalJSONFlags(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "func (e %s) MarshalJSON() ([]byte, error) {\n", e.Name)
+	fmt.Fprintf(w, "\tif e&^(%s) != 0 {\n", e.FlagMask())
+	fmt.Fprintf(w, "\t\treturn nil, &EnumJSONError{Type: %q, Value: fmt.Sprintf(\"%%#v\", e)}\n", e.Name)
+	fmt.Fprintf(w, "\t}\n")
+	if g.encoding == EncodingValue {
+		fmt.Fprintf(w, "\treturn json.Marshal(int64(e))\n")
+		fmt.Fprintf(w, "}\n")
+		return
+	}
+	fmt.Fprintf(w, "\tnames := []string{}\n")
+	for _, c := range e.FlagKeys() {
+		fmt.Fprintf(w, "\tif e&%s == %s {\n", c.GoName, c.GoName)
+		fmt.Fprintf(w, "\t\tnames = append(names, %q)\n", c.Name)
+		fmt.Fprintf(w, "\t}\n")
+	}
+	fmt.Fprintf(w, "\treturn json.Marshal(names)\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+// writeUnmarshalJSONFlags writes UnmarshalJSON for bit flags, which sets e to the flags in the given list,
+// or to the given number with EncodingValue.
+func (g *generator) writeUnmarshalJSONFlags(e enum.EnumType, unknown *enum.EnumKey, w io.Writer) {
+	fmt.Fprintf(w, "func (e *%s) UnmarshalJSON(b []byte) error {\n", e.Name)
+	g.writeUnmarshalJSONNull(w)
+	if g.encoding == EncodingValue {
+		fmt.Fprintf(w, "\tvar v json.Number\n")
+		fmt.Fprintf(w, "\tif err := json.Unmarshal(b, &v); err != nil {\n")
+		fmt.Fprintf(w, "\t\treturn err\n")
+		fmt.Fprintf(w, "\t}\n")
+		fmt.Fprintf(w, "\tif i, err := v.Int64(); err == nil && %s(i)&^(%s) == 0 {\n", e.Name, e.FlagMask())
+		fmt.Fprintf(w, "\t\t*e = %s(i)\n", e.Name)
+		fmt.Fprintf(w, "\t\treturn nil\n")
+		fmt.Fprintf(w, "\t}\n")
+		if unknown != nil {
+			fmt.Fprintf(w, "\t*e = %s\n", unknown.GoName)
+			if g.reportUnknown != "" {
+				fmt.Fprintf(w, "\t%s(%q, string(v))\n", g.reportUnknown, e.Name)
+			}
+			fmt.Fprintf(w, "\treturn nil\n")
+		} else {
+			fmt.Fprintf(w, "\treturn &EnumJSONError{Type: %q, Value: string(v)}\n", e.Name)
+		}
+		fmt.Fprintf(w, "}\n")
+		return
+	}
+	fmt.Fprintf(w, "\tvar v []string\n")
+	fmt.Fprintf(w, "\tif err := json.Unmarshal(b, &v); err != nil {\n")
+	fmt.Fprintf(w, "\t\treturn err\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tvar flags %s\n", e.Name)
+	fmt.Fprintf(w, "\tfor _, s := range v {\n")
+	fmt.Fprintf(w, "\t\tswitch s {\n")
+	for _, c := range e.FlagKeys() {
+		fmt.Fprintf(w, "\t\tcase %q:\n", c.Name)
+		fmt.Fprintf(w, "\t\t\tflags |= %s\n", c.GoName)
+	}
+	fmt.Fprintf(w, "\t\tdefault:\n")
+	if unknown != nil {
+		fmt.Fprintf(w, "\t\t\tflags |= %s\n", unknown.GoName)
+		if g.reportUnknown != "" {
+			fmt.Fprintf(w, "\t\t\t%s(%q, s)\n", g.reportUnknown, e.Name)
+		}
+	} else {
+		fmt.Fprintf(w, "\t\t\treturn &EnumJSONError{Type: %q, Value: s}\n", e.Name)
+	}
+	fmt.Fprintf(w, "\t\t}\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\t*e = flags\n")
+	fmt.Fprintf(w, "\treturn nil\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+// writeUnmarshalJSONNull writes the beginning of UnmarshalJSON which leaves e unchanged for null
+// as encoding/json does for other types.
+func (g *generator) writeUnmarshalJSONNull(w io.Writer) {
+	fmt.Fprintf(w, "\tif string(b) == \"null\" {\n")
+	fmt.Fprintf(w, "\t\treturn nil\n")
+	fmt.Fprintf(w, "\t}\n")
+}
+
+// jsonToken returns the JSON token of the key.
+func (g *generator) jsonToken(e enum.EnumType, c enum.EnumKey) (string, error) {
+	var v interface{} = c.Name
+	if g.encoding == EncodingValue {
+		if e.Kind != enum.EnumKindString {
+			return c.Value, nil
+		}
+		s, err := strconv.Unquote(c.Value)
+		if err != nil {
+			return "", err
+		}
+		v = s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// isNumber returns true if the enum is encoded as JSON numbers.
+func (g *generator) isNumber(e enum.EnumType) bool {
+	return g.encoding == EncodingValue && (e.Kind == enum.EnumKindInteger || e.Kind == enum.EnumKindFloat)
+}
@@ -0,0 +1,227 @@
+package jsonschema
+
+import (
+	encjson "encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/yssk22/go-generators/enum"
+	"github.com/yssk22/go-generators/enum/json"
+)
+
+const (
+	schemaURI = "https://json-schema.org/draft/2020-12/schema"
+
+	generatedFilename        = "enums.schema.json"
+	generatedOpenAPIFilename = "enums.openapi.json"
+)
+
+// Format is a format of the generated document.
+type Format string
+
+const (
+	// FormatJSONSchema writes a JSON Schema (draft 2020-12) document with enums in $defs.
+	FormatJSONSchema = Format("jsonschema")
+	// FormatOpenAPI writes an OpenAPI 3 document fragment with enums in components.schemas.
+	FormatOpenAPI = Format("openapi")
+)
+
+type generator struct {
+	filename        string
+	format          Format
+	encoding        json.Encoding
+	descriptionFunc func(enum.EnumKey) string
+}
+
+type Option func(*generator) *generator
+
+// OutputFormat configures the document format. FormatJSONSchema is used by default.
+func OutputFormat(f Format) Option {
+	return func(g *generator) *generator {
+		g.format = f
+		return g
+	}
+}
+
+// Filename configures the path of the document. The default depends on the format.
+func Filename(name string) Option {
+	return func(g *generator) *generator {
+		g.filename = name
+		return g
+	}
+}
+
+// EncodeBy configures the representation of enum values. It should be same as the one for enum/json generator.
+// json.EncodingName is used by default.
+func EncodeBy(e json.Encoding) Option {
+	return func(g *generator) *generator {
+		g.encoding = e
+		return g
+	}
+}
+
+// DescriptionFunc configures the description of each value, which is written in x-enum-descriptions.
+// EnumKey.Description is used by default.
+func DescriptionFunc(f func(enum.EnumKey) string) Option {
+	return func(g *generator) *generator {
+		g.descriptionFunc = f
+		return g
+	}
+}
+
+func NewGenerator(options ...Option) enum.Generator {
+	g := &generator{
+		format:   FormatJSONSchema,
+		encoding: json.EncodingName,
+		descriptionFunc: func(k enum.EnumKey) string {
+			return k.Description
+		},
+	}
+	for _, opts := range options {
+		g = opts(g)
+	}
+	if g.filename == "" {
+		g.filename = generatedFilename
+		if g.format == FormatOpenAPI {
+			g.filename = generatedOpenAPIFilename
+		}
+	}
+	return g
+}
+
+func (g *generator) Filename() string {
+	return g.filename
+}
+
+type schema struct {
+	Type             string        `json:"type"`
+	Enum             []interface{} `json:"enum,omitempty"`
+	EnumDescriptions []string      `json:"x-enum-descriptions,omitempty"`
+	Items            *schema       `json:"items,omitempty"`
+	UniqueItems      bool          `json:"uniqueItems,omitempty"`
+}
+
+type jsonSchemaDocument struct {
+	Schema string             `json:"$schema"`
+	Defs   map[string]*schema `json:"$defs"`
+}
+
+type openAPIDocument struct {
+	Components struct {
+		Schemas map[string]*schema `json:"schemas"`
+	} `json:"components"`
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	schemas := make(map[string]*schema)
+	for _, e := range enums {
+		s, err := g.newSchema(e)
+		if err != nil {
+			return fmt.Errorf("%s: %w", e.Name, err)
+		}
+		schemas[e.Name] = s
+	}
+	var doc interface{}
+	switch g.format {
+	case FormatOpenAPI:
+		d := &openAPIDocument{}
+		d.Components.Schemas = schemas
+		doc = d
+		break
+	case FormatJSONSchema:
+		doc = &jsonSchemaDocument{
+			Schema: schemaURI,
+			Defs:   schemas,
+		}
+		break
+	default:
+		return fmt.Errorf("unknown format: %s", g.format)
+	}
+	encoder := encjson.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
+
+func (g *generator) newSchema(e enum.EnumType) (*schema, error) {
+	if e.Flags {
+		return g.newFlagsSchema(e)
+	}
+	s := &schema{
+		Type: "string",
+	}
+	if g.encoding == json.EncodingValue {
+		switch e.Kind {
+		case enum.EnumKindInteger:
+			s.Type = "integer"
+			break
+		case enum.EnumKindFloat:
+			s.Type = "number"
+			break
+		case enum.EnumKindBoolean:
+			s.Type = "boolean"
+			break
+		}
+	}
+	// names of constants sharing the same value are all accepted by UnmarshalJSON but values are not distinct.
+	keys := e.Keys
+	if g.encoding == json.EncodingValue {
+		keys = e.DistinctKeys()
+	}
+	var hasDescription bool
+	for _, c := range keys {
+		v, err := g.value(e, c)
+		if err != nil {
+			return nil, err
+		}
+		s.Enum = append(s.Enum, v)
+		desc := g.descriptionFunc(c)
+		if desc != "" {
+			hasDescription = true
+		}
+		s.EnumDescriptions = append(s.EnumDescriptions, desc)
+	}
+	if !hasDescription {
+		s.EnumDescriptions = nil
+	}
+	return s, nil
+}
+
+// newFlagsSchema returns the schema of bit flags which enum/json encodes as a list of the flag names,
+// or as the number of any combination of the flags with json.EncodingValue.
+func (g *generator) newFlagsSchema(e enum.EnumType) (*schema, error) {
+	if g.encoding == json.EncodingValue {
+		return &schema{Type: "integer"}, nil
+	}
+	items := &schema{
+		Type: "string",
+	}
+	var hasDescription bool
+	for _, c := range e.FlagKeys() {
+		items.Enum = append(items.Enum, c.Name)
+		desc := g.descriptionFunc(c)
+		if desc != "" {
+			hasDescription = true
+		}
+		items.EnumDescriptions = append(items.EnumDescriptions, desc)
+	}
+	if !hasDescription {
+		items.EnumDescriptions = nil
+	}
+	return &schema{
+		Type:        "array",
+		Items:       items,
+		UniqueItems: true,
+	}, nil
+}
+
+func (g *generator) value(e enum.EnumType, c enum.EnumKey) (interface{}, error) {
+	if g.encoding != json.EncodingValue {
+		return c.Name, nil
+	}
+	if e.Kind == enum.EnumKindString {
+		return strconv.Unquote(c.Value)
+	}
+	// c.Value is a Go literal which is also a valid JSON number or boolean
+	return encjson.RawMessage(c.Value), nil
+}
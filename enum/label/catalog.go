@@ -0,0 +1,66 @@
+package label
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedCatalogFilename = "enums.catalog.json"
+)
+
+type catalogGenerator struct {
+	filename string
+}
+
+type CatalogOption func(*catalogGenerator) *catalogGenerator
+
+// Filename configures the path of the catalog file.
+func Filename(name string) CatalogOption {
+	return func(g *catalogGenerator) *catalogGenerator {
+		g.filename = name
+		return g
+	}
+}
+
+// NewCatalogGenerator returns a generator to write a JSON catalog of the labels and descriptions for translators.
+// The catalog is an object keyed by the enum type name and EnumKey.Name.
+func NewCatalogGenerator(options ...CatalogOption) enum.Generator {
+	g := &catalogGenerator{
+		filename: generatedCatalogFilename,
+	}
+	for _, opts := range options {
+		g = opts(g)
+	}
+	return g
+}
+
+func (g *catalogGenerator) Filename() string {
+	return g.filename
+}
+
+type catalogEntry struct {
+	Label       string            `json:"label"`
+	Description string            `json:"description,omitempty"`
+	Meta        map[string]string `json:"meta,omitempty"`
+}
+
+func (g *catalogGenerator) Generate(out io.Writer, enums []enum.EnumType) error {
+	catalog := make(map[string]map[string]*catalogEntry)
+	for _, e := range enums {
+		entries := make(map[string]*catalogEntry)
+		for _, c := range e.Keys {
+			entries[c.Name] = &catalogEntry{
+				Label:       c.Label(),
+				Description: c.Description,
+				Meta:        c.Meta,
+			}
+		}
+		catalog[e.Name] = entries
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(catalog)
+}
@@ -0,0 +1,96 @@
+package label
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename = "label_enums.go"
+)
+
+type generator struct {
+}
+
+// NewGenerator returns a generator to write Label(), Description() and Meta() of enums from the doc comments
+// and the trailing annotations of the constants.
+func NewGenerator() enum.Generator {
+	return &generator{}
+}
+
+func (g *generator) Filename() string {
+	return generatedFilename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	for _, e := range enums {
+		g.writeLabel(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeDescription(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeMeta(e, out)
+		fmt.Fprint(out, "\n")
+	}
+	return nil
+}
+
+func (g *generator) writeLabel(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "// Label returns the human readable label of the value.\n")
+	fmt.Fprintf(w, "func (e %s) Label() string {\n", e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, c := range e.DistinctKeys() {
+		fmt.Fprintf(w, "\tcase %s:\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn %q\n", c.Label())
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn \"\"\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeDescription(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "// Description returns the doc comment of the value.\n")
+	fmt.Fprintf(w, "func (e %s) Description() string {\n", e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, c := range e.DistinctKeys() {
+		if c.Description == "" {
+			continue
+		}
+		fmt.Fprintf(w, "\tcase %s:\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn %q\n", c.Description)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn \"\"\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeMeta(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "// Meta returns the annotations of the value. The returned map can be modified by the caller.\n")
+	fmt.Fprintf(w, "func (e %s) Meta() map[string]string {\n", e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, c := range e.DistinctKeys() {
+		if len(c.Meta) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\tcase %s:\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn map[string]string{\n")
+		for _, k := range sortedKeys(c.Meta) {
+			fmt.Fprintf(w, "\t\t\t%q: %q,\n", k, c.Meta[k])
+		}
+		fmt.Fprintf(w, "\t\t}\n")
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn nil\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,127 @@
+package ordered
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename = "ordered_enums.go"
+)
+
+type generator struct {
+}
+
+// NewGenerator returns a generator to write comparison and range helpers for enums declared by `//enum:ordered`
+// based on the declaration order of the constants. Other enums are ignored.
+func NewGenerator() enum.Generator {
+	return &generator{}
+}
+
+func (g *generator) Filename() string {
+	return generatedFilename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	for _, e := range enums {
+		if !e.Ordered || len(e.Keys) == 0 {
+			continue
+		}
+		g.writeOrdinal(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeCompare(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeNext(e, out)
+		fmt.Fprint(out, "\n")
+		g.writePrev(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeMinMax(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeBetween(e, out)
+		fmt.Fprint(out, "\n")
+	}
+	return nil
+}
+
+func (g *generator) writeOrdinal(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "// ordinal returns the position of e in the declaration order, or -1 if e is not declared.\n")
+	fmt.Fprintf(w, "func (e %s) ordinal() int {\n", e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, c := range e.DistinctKeys() {
+		fmt.Fprintf(w, "\tcase %s:\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn %d\n", c.Ordinal)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn -1\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeCompare(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "// Compare returns -1, 0 or +1 if e is declared before, same as or after other.\n")
+	fmt.Fprintf(w, "// Undeclared values are before all declared values.\n")
+	fmt.Fprintf(w, "func (e %s) Compare(other %s) int {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\ta, b := e.ordinal(), other.ordinal()\n")
+	fmt.Fprintf(w, "\tif a < b {\n")
+	fmt.Fprintf(w, "\t\treturn -1\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tif a > b {\n")
+	fmt.Fprintf(w, "\t\treturn 1\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn 0\n")
+	fmt.Fprintf(w, "}\n")
+	fmt.Fprint(w, "\n")
+	fmt.Fprintf(w, "// Less returns true if e is declared before other.\n")
+	fmt.Fprintf(w, "func (e %s) Less(other %s) bool {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\treturn e.ordinal() < other.ordinal()\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeNext(e enum.EnumType, w io.Writer) {
+	keys := e.DistinctKeys()
+	fmt.Fprintf(w, "// Next returns the value declared next to e, or false if e is the last or not declared.\n")
+	fmt.Fprintf(w, "func (e %s) Next() (%s, bool) {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for i := 0; i < len(keys)-1; i++ {
+		fmt.Fprintf(w, "\tcase %s:\n", keys[i].GoName)
+		fmt.Fprintf(w, "\t\treturn %s, true\n", keys[i+1].GoName)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn e, false\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writePrev(e enum.EnumType, w io.Writer) {
+	keys := e.DistinctKeys()
+	fmt.Fprintf(w, "// Prev returns the value declared previous to e, or false if e is the first or not declared.\n")
+	fmt.Fprintf(w, "func (e %s) Prev() (%s, bool) {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for i := 1; i < len(keys); i++ {
+		fmt.Fprintf(w, "\tcase %s:\n", keys[i].GoName)
+		fmt.Fprintf(w, "\t\treturn %s, true\n", keys[i-1].GoName)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn e, false\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeMinMax(e enum.EnumType, w io.Writer) {
+	keys := e.DistinctKeys()
+	fmt.Fprintf(w, "// Min%s returns the first declared value.\n", e.Name)
+	fmt.Fprintf(w, "func Min%s() %s {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\treturn %s\n", keys[0].GoName)
+	fmt.Fprintf(w, "}\n")
+	fmt.Fprint(w, "\n")
+	fmt.Fprintf(w, "// Max%s returns the last declared value.\n", e.Name)
+	fmt.Fprintf(w, "func Max%s() %s {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\treturn %s\n", keys[len(keys)-1].GoName)
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeBetween(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "// Between returns true if e is declared between a and b inclusive.\n")
+	fmt.Fprintf(w, "func (e %s) Between(a, b %s) bool {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\treturn a.Compare(e) <= 0 && e.Compare(b) <= 0\n")
+	fmt.Fprintf(w, "}\n")
+}
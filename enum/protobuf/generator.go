@@ -0,0 +1,194 @@
+package protobuf
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/yssk22/go-generators/enum"
+	"github.com/yssk22/go-generators/helper"
+)
+
+const (
+	generatedFilename          = "enums.proto"
+	generatedConverterFilename = "protobuf_enums.go"
+)
+
+type fileOption struct {
+	name  string
+	value string
+}
+
+type generator struct {
+	filename     string
+	protoPackage string
+	options      []fileOption
+}
+
+type Option func(*generator) *generator
+
+// Filename configures the name of .proto file. enums.proto is used by default.
+func Filename(name string) Option {
+	return func(g *generator) *generator {
+		g.filename = name
+		return g
+	}
+}
+
+// ProtoPackage configures the package declaration of .proto file.
+func ProtoPackage(name string) Option {
+	return func(g *generator) *generator {
+		g.protoPackage = name
+		return g
+	}
+}
+
+// GoPackage configures `option go_package` of .proto file.
+func GoPackage(importPath string) Option {
+	return FileOption("go_package", importPath)
+}
+
+// FileOption adds `option {name} = "{value}";` to .proto file.
+func FileOption(name string, value string) Option {
+	return func(g *generator) *generator {
+		g.options = append(g.options, fileOption{name: name, value: value})
+		return g
+	}
+}
+
+// NewGenerator returns a generator to write a .proto file which has an enum for each Go enum.
+// The numeric tags are the values of integer enums, or declared by `enum:proto=N` directive on the constants,
+// which is required for other enums and overrides the values of integer enums.
+// 0 is {ENUM}_UNSPECIFIED unless a key takes the tag. Removed tags or keys should be declared by
+// `//enum:proto_reserved=2,Archived` on the type so that they are never reused.
+func NewGenerator(options ...Option) enum.Generator {
+	g := &generator{
+		filename: generatedFilename,
+	}
+	for _, opts := range options {
+		g = opts(g)
+	}
+	return g
+}
+
+func (g *generator) Filename() string {
+	return g.filename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	fmt.Fprintf(out, "syntax = \"proto3\";\n")
+	fmt.Fprintf(out, "\n")
+	if g.protoPackage != "" {
+		fmt.Fprintf(out, "package %s;\n", g.protoPackage)
+		fmt.Fprintf(out, "\n")
+	}
+	if len(g.options) > 0 {
+		for _, o := range g.options {
+			fmt.Fprintf(out, "option %s = %q;\n", o.name, o.value)
+		}
+		fmt.Fprintf(out, "\n")
+	}
+	for i, e := range enums {
+		values, err := newProtoEnum(e)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			fmt.Fprint(out, "\n")
+		}
+		fmt.Fprintf(out, "enum %s {\n", e.Name)
+		if len(values.reservedTags) > 0 {
+			fmt.Fprintf(out, "  reserved %s;\n", strings.Join(values.reservedTags, ", "))
+		}
+		if len(values.reservedNames) > 0 {
+			var quoted []string
+			for _, name := range values.reservedNames {
+				quoted = append(quoted, strconv.Quote(name))
+			}
+			fmt.Fprintf(out, "  reserved %s;\n", strings.Join(quoted, ", "))
+		}
+		for _, v := range values.values {
+			fmt.Fprintf(out, "  %s = %d;\n", v.name, v.tag)
+		}
+		fmt.Fprintf(out, "}\n")
+	}
+	return nil
+}
+
+type converterGenerator struct {
+	importPath string
+}
+
+// NewConverterGenerator returns a generator to write conversion functions between Go enums and
+// the ones generated by protoc-gen-go in importPath.
+func NewConverterGenerator(importPath string) enum.Generator {
+	return &converterGenerator{
+		importPath: importPath,
+	}
+}
+
+func (g *converterGenerator) Filename() string {
+	return generatedConverterFilename
+}
+
+func (g *converterGenerator) Generate(out io.Writer, enums []enum.EnumType) error {
+	fmt.Fprintf(out, "import (\n")
+	fmt.Fprintf(out, "\t\"fmt\"\n")
+	fmt.Fprintf(out, "\n")
+	fmt.Fprintf(out, "\tpb %q\n", g.importPath)
+	fmt.Fprintf(out, ")\n")
+	fmt.Fprintf(out, "\n")
+	for _, e := range enums {
+		values, err := newProtoEnum(e)
+		if err != nil {
+			return err
+		}
+		g.writeToProto(e, values, out)
+		fmt.Fprint(out, "\n")
+		g.writeFromProto(e, values, out)
+		fmt.Fprint(out, "\n")
+	}
+	return nil
+}
+
+func (g *converterGenerator) writeToProto(e enum.EnumType, values *protoEnum, w io.Writer) {
+	fmt.Fprintf(w, "func (e %s) ToProto() pb.%s {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, v := range values.values {
+		if v.key == nil {
+			continue
+		}
+		fmt.Fprintf(w, "\tcase %s:\n", v.key.GoName)
+		fmt.Fprintf(w, "\t\treturn pb.%s_%s\n", e.Name, v.name)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn pb.%s_%s\n", e.Name, values.values[0].name)
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *converterGenerator) writeFromProto(e enum.EnumType, values *protoEnum, w io.Writer) {
+	fmt.Fprintf(w, "func %sFromProto(v pb.%s) (%s, error) {\n", e.Name, e.Name, e.Name)
+	fmt.Fprintf(w, "\tswitch v {\n")
+	for _, v := range values.values {
+		if v.key == nil {
+			continue
+		}
+		fmt.Fprintf(w, "\tcase pb.%s_%s:\n", e.Name, v.name)
+		fmt.Fprintf(w, "\t\treturn %s, nil\n", v.key.GoName)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tvar zero %s\n", e.Name)
+	fmt.Fprintf(w, "\treturn zero, fmt.Errorf(\"invalid %s: %%v\", v)\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+}
+
+// valueName returns the proto enum value name prefixed by the enum name as protobuf style guide recommends.
+// (e.g. MY_ENUM_VALUE_A for MyEnumValueA)
+func valueName(e enum.EnumType, c enum.EnumKey) string {
+	return fmt.Sprintf("%s_%s", helper.ToScreamingSnakeCase(e.Name), helper.ToScreamingSnakeCase(c.Name))
+}
+
+func unspecifiedValueName(e enum.EnumType) string {
+	return fmt.Sprintf("%s_UNSPECIFIED", helper.ToScreamingSnakeCase(e.Name))
+}
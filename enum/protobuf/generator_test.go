@@ -0,0 +1,152 @@
+package protobuf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+func TestGenerator_Generate(t *testing.T) {
+	cases := []struct {
+		name     string
+		enum     enum.EnumType
+		expected string
+		err      bool
+	}{
+		{
+			name: "String",
+			enum: enum.EnumType{
+				Name: "Status",
+				Kind: enum.EnumKindString,
+				Keys: []enum.EnumKey{
+					{GoName: "StatusActive", Name: "Active", Value: `"active"`, ProtoTag: "1"},
+					{GoName: "StatusDisabled", Name: "Disabled", Value: `"disabled"`, ProtoTag: "5"},
+					{GoName: "StatusInactive", Name: "Inactive", Value: `"inactive"`, ProtoTag: "3"},
+				},
+				ProtoReserved: []string{"2", "Archived"},
+			},
+			expected: `enum Status {
+  reserved 2;
+  reserved "STATUS_ARCHIVED";
+  STATUS_UNSPECIFIED = 0;
+  STATUS_ACTIVE = 1;
+  STATUS_DISABLED = 5;
+  STATUS_INACTIVE = 3;
+}
+`,
+		},
+		{
+			name: "Integer",
+			enum: enum.EnumType{
+				Name: "Priority",
+				Kind: enum.EnumKindInteger,
+				Keys: []enum.EnumKey{
+					{GoName: "PriorityLow", Name: "Low", Value: "1"},
+					{GoName: "PriorityNone", Name: "None", Value: "0"},
+					{GoName: "PriorityHigh", Name: "High", Value: "10"},
+				},
+			},
+			expected: `enum Priority {
+  PRIORITY_NONE = 0;
+  PRIORITY_LOW = 1;
+  PRIORITY_HIGH = 10;
+}
+`,
+		},
+		{
+			name: "DuplicateTag",
+			enum: enum.EnumType{
+				Name: "Status",
+				Kind: enum.EnumKindString,
+				Keys: []enum.EnumKey{
+					{GoName: "StatusActive", Name: "Active", Value: `"active"`, ProtoTag: "1"},
+					{GoName: "StatusDisabled", Name: "Disabled", Value: `"disabled"`, ProtoTag: "1"},
+				},
+			},
+			err: true,
+		},
+		{
+			name: "ReservedTag",
+			enum: enum.EnumType{
+				Name: "Priority",
+				Kind: enum.EnumKindInteger,
+				Keys: []enum.EnumKey{
+					{GoName: "PriorityLow", Name: "Low", Value: "1"},
+				},
+				ProtoReserved: []string{"1"},
+			},
+			err: true,
+		},
+		{
+			name: "ReservedName",
+			enum: enum.EnumType{
+				Name: "Status",
+				Kind: enum.EnumKindString,
+				Keys: []enum.EnumKey{
+					{GoName: "StatusArchived", Name: "Archived", Value: `"archived"`, ProtoTag: "1"},
+				},
+				ProtoReserved: []string{"Archived"},
+			},
+			err: true,
+		},
+		{
+			name: "UnspecifiedConflict",
+			enum: enum.EnumType{
+				Name: "Status",
+				Kind: enum.EnumKindString,
+				Keys: []enum.EnumKey{
+					{GoName: "StatusUnspecified", Name: "Unspecified", Value: `""`, ProtoTag: "1"},
+				},
+			},
+			err: true,
+		},
+		{
+			name: "Unspecified",
+			enum: enum.EnumType{
+				Name: "Status",
+				Kind: enum.EnumKindString,
+				Keys: []enum.EnumKey{
+					{GoName: "StatusUnspecified", Name: "Unspecified", Value: `""`, ProtoTag: "0"},
+					{GoName: "StatusActive", Name: "Active", Value: `"active"`, ProtoTag: "1"},
+				},
+			},
+			expected: `enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_ACTIVE = 1;
+}
+`,
+		},
+		{
+			name: "MissingTag",
+			enum: enum.EnumType{
+				Name: "Status",
+				Kind: enum.EnumKindString,
+				Keys: []enum.EnumKey{
+					{GoName: "StatusActive", Name: "Active", Value: `"active"`, ProtoTag: "1"},
+					{GoName: "StatusInactive", Name: "Inactive", Value: `"inactive"`},
+				},
+			},
+			err: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			var buff bytes.Buffer
+			err := NewGenerator().Generate(&buff, []enum.EnumType{c.enum})
+			if c.err {
+				if err == nil {
+					tt.Errorf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			expected := "syntax = \"proto3\";\n\n" + c.expected
+			if buff.String() != expected {
+				tt.Errorf("expected: %v, got: %v", expected, buff.String())
+			}
+		})
+	}
+}
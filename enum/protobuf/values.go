@@ -0,0 +1,95 @@
+package protobuf
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+// protoValue is a value of the proto enum. key is nil for {ENUM}_UNSPECIFIED.
+type protoValue struct {
+	name string
+	tag  int64
+	key  *enum.EnumKey
+}
+
+// protoEnum is a proto enum converted from a Go enum. The first value has the tag 0 as proto3 requires.
+type protoEnum struct {
+	values        []protoValue
+	reservedTags  []string
+	reservedNames []string
+}
+
+// newProtoEnum assigns the tags to the keys of e and validates them against each other and the reserved ones.
+func newProtoEnum(e enum.EnumType) (*protoEnum, error) {
+	p := &protoEnum{}
+	reservedTags := make(map[int64]bool)
+	reservedNames := make(map[string]bool)
+	for _, r := range e.ProtoReserved {
+		if tag, err := strconv.ParseInt(r, 10, 32); err == nil {
+			reservedTags[tag] = true
+			p.reservedTags = append(p.reservedTags, r)
+			continue
+		}
+		name := valueName(e, enum.EnumKey{Name: r})
+		reservedNames[name] = true
+		p.reservedNames = append(p.reservedNames, name)
+	}
+	var values []protoValue
+	var zero *protoValue
+	tags := make(map[int64]string)
+	keys := e.DistinctKeys()
+	for i := range keys {
+		c := &keys[i]
+		tag, err := protoTag(e, *c)
+		if err != nil {
+			return nil, err
+		}
+		v := protoValue{name: valueName(e, *c), tag: tag, key: c}
+		if other, ok := tags[tag]; ok {
+			return nil, fmt.Errorf("%s: %s and %s have the same protobuf tag %d", e.Name, other, c.GoName, tag)
+		}
+		tags[tag] = c.GoName
+		if reservedTags[tag] {
+			return nil, fmt.Errorf("%s: the protobuf tag %d of %s is reserved", e.Name, tag, c.GoName)
+		}
+		if reservedNames[v.name] {
+			return nil, fmt.Errorf("%s: the protobuf name %s of %s is reserved", e.Name, v.name, c.GoName)
+		}
+		if tag == 0 {
+			zero = &v
+			continue
+		}
+		values = append(values, v)
+	}
+	if zero == nil {
+		unspecified := unspecifiedValueName(e)
+		for _, v := range values {
+			if v.name == unspecified {
+				return nil, fmt.Errorf("%s: %s conflicts with %s = 0, use enum:proto=0 or enum:name", e.Name, v.key.GoName, unspecified)
+			}
+		}
+		zero = &protoValue{name: unspecified}
+	}
+	p.values = append([]protoValue{*zero}, values...)
+	return p, nil
+}
+
+// protoTag returns the tag declared by `enum:proto=N`, or the value for integer enums. Other enums must declare
+// the tags as the declaration order is not stable enough to be the wire format.
+func protoTag(e enum.EnumType, c enum.EnumKey) (int64, error) {
+	literal := c.ProtoTag
+	if literal == "" && e.Kind != enum.EnumKindInteger {
+		return 0, fmt.Errorf("%s: %s has no protobuf tag, declare it by enum:proto=N", e.Name, c.GoName)
+	}
+	if literal == "" {
+		literal = c.Value
+	}
+	tag, err := strconv.ParseInt(literal, 10, 64)
+	if err != nil || tag < math.MinInt32 || tag > math.MaxInt32 {
+		return 0, fmt.Errorf("%s: %s is not a valid protobuf tag of %s", e.Name, literal, c.GoName)
+	}
+	return tag, nil
+}
@@ -0,0 +1,84 @@
+// Package registry is a runtime registry of enums so that tools like admin UIs can list the allowed values
+// of any enum without importing each type. Enums are registered by the code generated by enum/registrygen.
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Key is a key of the enum.
+type Key struct {
+	GoName string
+	Name   string
+	Value  interface{} // the constant value typed as the enum
+}
+
+// Enum is a registered enum.
+type Enum struct {
+	Name    string
+	PkgPath string
+	Keys    []Key // in the declaration order
+}
+
+// FullName returns the name qualified by the package path like `github.com/me/myapp/models.MyEnum`.
+func (e *Enum) FullName() string {
+	return fmt.Sprintf("%s.%s", e.PkgPath, e.Name)
+}
+
+// Names returns the names of the keys.
+func (e *Enum) Names() []string {
+	var names []string
+	for _, k := range e.Keys {
+		names = append(names, k.Name)
+	}
+	return names
+}
+
+// Values returns the values of the keys.
+func (e *Enum) Values() []interface{} {
+	var values []interface{}
+	for _, k := range e.Keys {
+		values = append(values, k.Value)
+	}
+	return values
+}
+
+var (
+	mu    sync.RWMutex
+	enums = make(map[string]*Enum)
+)
+
+// Register registers the enum. It panics if the enum with the same full name is already registered.
+func Register(e *Enum) {
+	mu.Lock()
+	defer mu.Unlock()
+	name := e.FullName()
+	if _, dup := enums[name]; dup {
+		panic(fmt.Sprintf("registry: Register called twice for %s", name))
+	}
+	enums[name] = e
+}
+
+// Lookup returns the enum by the package path and the type name.
+func Lookup(pkgPath string, name string) (*Enum, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	e, ok := enums[fmt.Sprintf("%s.%s", pkgPath, name)]
+	return e, ok
+}
+
+// All returns all registered enums ordered by their full names.
+func All() []*Enum {
+	mu.RLock()
+	defer mu.RUnlock()
+	var list []*Enum
+	for _, e := range enums {
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].FullName() < list[j].FullName()
+	})
+	return list
+}
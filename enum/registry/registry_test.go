@@ -0,0 +1,50 @@
+package registry
+
+import (
+	"reflect"
+	"testing"
+)
+
+type myEnum string
+
+func TestRegistry(t *testing.T) {
+	Register(&Enum{
+		Name:    "Zeta",
+		PkgPath: "example.com/enums",
+		Keys:    []Key{{GoName: "ZetaA", Name: "A", Value: myEnum("a")}},
+	})
+	Register(&Enum{
+		Name:    "Alpha",
+		PkgPath: "example.com/enums",
+		Keys: []Key{
+			{GoName: "AlphaB", Name: "B", Value: myEnum("b")},
+			{GoName: "AlphaA", Name: "A", Value: myEnum("a")},
+		},
+	})
+	e, ok := Lookup("example.com/enums", "Alpha")
+	if !ok {
+		t.Fatalf("Alpha is not registered")
+	}
+	if expect := []string{"B", "A"}; !reflect.DeepEqual(expect, e.Names()) {
+		t.Errorf("expected: %v, got: %v", expect, e.Names())
+	}
+	if expect := []interface{}{myEnum("b"), myEnum("a")}; !reflect.DeepEqual(expect, e.Values()) {
+		t.Errorf("expected: %v, got: %v", expect, e.Values())
+	}
+	if _, ok := Lookup("example.com/other", "Alpha"); ok {
+		t.Errorf("expected: %v, got: %v", false, ok)
+	}
+	var names []string
+	for _, e := range All() {
+		names = append(names, e.FullName())
+	}
+	if expect := []string{"example.com/enums.Alpha", "example.com/enums.Zeta"}; !reflect.DeepEqual(expect, names) {
+		t.Errorf("expected: %v, got: %v", expect, names)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic on duplicate registration")
+		}
+	}()
+	Register(&Enum{Name: "Alpha", PkgPath: "example.com/enums"})
+}
@@ -0,0 +1,50 @@
+package registrygen
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename = "registry_enums.go"
+
+	registryImportPath = "github.com/yssk22/go-generators/enum/registry"
+)
+
+type generator struct {
+}
+
+// NewGenerator returns a generator to write init() registering the enums to enum/registry.
+func NewGenerator() enum.Generator {
+	return &generator{}
+}
+
+func (g *generator) Filename() string {
+	return generatedFilename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	if len(enums) == 0 {
+		return nil
+	}
+	fmt.Fprintf(out, "import (\n")
+	fmt.Fprintf(out, "\t%q\n", registryImportPath)
+	fmt.Fprintf(out, ")\n")
+	fmt.Fprintf(out, "\n")
+	fmt.Fprintf(out, "func init() {\n")
+	for _, e := range enums {
+		fmt.Fprintf(out, "\tregistry.Register(&registry.Enum{\n")
+		fmt.Fprintf(out, "\t\tName:    %q,\n", e.Name)
+		fmt.Fprintf(out, "\t\tPkgPath: %q,\n", e.PkgPath)
+		fmt.Fprintf(out, "\t\tKeys: []registry.Key{\n")
+		for _, c := range e.Keys {
+			fmt.Fprintf(out, "\t\t\t{GoName: %q, Name: %q, Value: %s},\n", c.GoName, c.Name, c.GoName)
+		}
+		fmt.Fprintf(out, "\t\t},\n")
+		fmt.Fprintf(out, "\t})\n")
+	}
+	fmt.Fprintf(out, "}\n")
+	return nil
+}
@@ -0,0 +1,270 @@
+package sql
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yssk22/go-generators/enum"
+	"github.com/yssk22/go-generators/helper"
+)
+
+const (
+	generatedMigrationFilename = "enums.migration.sql"
+)
+
+// Dialect is a SQL dialect of DDL.
+type Dialect string
+
+const (
+	// DialectPostgres writes `CREATE TYPE ... AS ENUM (...)` statements.
+	DialectPostgres = Dialect("postgres")
+	// DialectMySQL writes `ENUM(...)` column definitions.
+	DialectMySQL = Dialect("mysql")
+)
+
+// WithDialect configures the dialect of DDL. DialectPostgres is used by default.
+func WithDialect(d Dialect) Option {
+	return func(c *config) *config {
+		c.dialect = d
+		return c
+	}
+}
+
+// Filename configures the path of the DDL or migration file.
+func Filename(name string) Option {
+	return func(c *config) *config {
+		c.filename = name
+		return c
+	}
+}
+
+// Force allows the migration to drop types and ignore removed values.
+func Force() Option {
+	return func(c *config) *config {
+		c.force = true
+		return c
+	}
+}
+
+type ddlGenerator struct {
+	*config
+}
+
+// NewDDLGenerator returns a generator to write DDL for enums, which is enums.{dialect}.sql by default.
+// Enum types are named by the snake case of Go type names.
+func NewDDLGenerator(options ...Option) enum.Generator {
+	return &ddlGenerator{
+		config: newConfig(options),
+	}
+}
+
+func (g *ddlGenerator) Filename() string {
+	if g.filename != "" {
+		return g.filename
+	}
+	return fmt.Sprintf("enums.%s.sql", g.dialect)
+}
+
+func (g *ddlGenerator) Generate(out io.Writer, enums []enum.EnumType) error {
+	for i, e := range enums {
+		values, err := g.values(e)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			fmt.Fprint(out, "\n")
+		}
+		switch g.dialect {
+		case DialectPostgres:
+			fmt.Fprintf(out, "CREATE TYPE %s AS ENUM (%s);\n", typeName(e), strings.Join(quoteAll(values), ", "))
+			break
+		case DialectMySQL:
+			fmt.Fprintf(out, "-- %s\n", e.Name)
+			fmt.Fprintf(out, "ENUM(%s)\n", strings.Join(quoteAll(values), ", "))
+			break
+		default:
+			return fmt.Errorf("unknown dialect: %s", g.dialect)
+		}
+	}
+	return nil
+}
+
+type migrationGenerator struct {
+	*config
+	snapshot string
+}
+
+// NewMigrationGenerator returns a generator to write PostgreSQL migration from the snapshot, which is the DDL
+// previously written by NewDDLGenerator, to the current enums. The snapshot path is relative to the package directory
+// unless it is absolute in the same way as the output files, so the migration generator must run before the DDL generator
+// overwrites the snapshot. Generate fails if a value or a type is removed unless Force() is given.
+func NewMigrationGenerator(snapshot string, options ...Option) enum.Generator {
+	return &migrationGenerator{
+		config:   newConfig(options),
+		snapshot: snapshot,
+	}
+}
+
+func (g *migrationGenerator) Filename() string {
+	if g.filename != "" {
+		return g.filename
+	}
+	return generatedMigrationFilename
+}
+
+// Generate writes the migration reading the snapshot relative to the current directory.
+func (g *migrationGenerator) Generate(out io.Writer, enums []enum.EnumType) error {
+	return g.GenerateInDir(out, ".", enums)
+}
+
+func (g *migrationGenerator) GenerateInDir(out io.Writer, dir string, enums []enum.EnumType) error {
+	previous, order, err := readSnapshot(enum.OutputPath(dir, g.snapshot))
+	if err != nil {
+		return err
+	}
+	var statements []string
+	current := make(map[string]bool)
+	for _, e := range enums {
+		values, err := g.values(e)
+		if err != nil {
+			return err
+		}
+		name := typeName(e)
+		current[name] = true
+		prevValues, ok := previous[name]
+		if !ok {
+			statements = append(statements, fmt.Sprintf("CREATE TYPE %s AS ENUM (%s);", name, strings.Join(quoteAll(values), ", ")))
+			continue
+		}
+		stmts, err := g.diff(name, prevValues, values)
+		if err != nil {
+			return err
+		}
+		statements = append(statements, stmts...)
+	}
+	for _, name := range order {
+		if current[name] {
+			continue
+		}
+		if !g.force {
+			return fmt.Errorf("enum type %s is removed, use Force() to drop it", name)
+		}
+		statements = append(statements, fmt.Sprintf("DROP TYPE %s;", name))
+	}
+	if len(statements) == 0 {
+		fmt.Fprintf(out, "-- no changes\n")
+		return nil
+	}
+	for _, s := range statements {
+		fmt.Fprintf(out, "%s\n", s)
+	}
+	return nil
+}
+
+// diff returns ALTER TYPE statements to add new values keeping the declaration order.
+func (g *migrationGenerator) diff(name string, previous []string, current []string) ([]string, error) {
+	var statements []string
+	existing := make(map[string]bool)
+	for _, v := range previous {
+		existing[v] = true
+	}
+	kept := make(map[string]bool)
+	for i, v := range current {
+		if existing[v] {
+			kept[v] = true
+			continue
+		}
+		if i == 0 {
+			if len(previous) == 0 {
+				statements = append(statements, fmt.Sprintf("ALTER TYPE %s ADD VALUE %s;", name, quote(v)))
+			} else {
+				statements = append(statements, fmt.Sprintf("ALTER TYPE %s ADD VALUE %s BEFORE %s;", name, quote(v), quote(previous[0])))
+			}
+		} else {
+			statements = append(statements, fmt.Sprintf("ALTER TYPE %s ADD VALUE %s AFTER %s;", name, quote(v), quote(current[i-1])))
+		}
+		existing[v] = true
+	}
+	for _, v := range previous {
+		if kept[v] {
+			continue
+		}
+		if !g.force {
+			return nil, fmt.Errorf("value %s is removed from enum type %s, use Force() to ignore it", quote(v), name)
+		}
+		// PostgreSQL cannot drop a value from enum types.
+		statements = append(statements, fmt.Sprintf("-- %s is removed from %s but PostgreSQL cannot drop enum values", quote(v), name))
+	}
+	return statements, nil
+}
+
+// values returns the values stored in the database.
+func (c *config) values(e enum.EnumType) ([]string, error) {
+	var values []string
+	if c.storage == StorageName {
+		for _, k := range e.Keys {
+			values = append(values, k.Name)
+		}
+		return values, nil
+	}
+	if e.Kind != enum.EnumKindString {
+		return nil, fmt.Errorf("%s: %s enum cannot be a database enum by value, use StoreBy(StorageName)", e.Name, e.Kind)
+	}
+	for _, k := range e.DistinctKeys() {
+		v, err := strconv.Unquote(k.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k.GoName, err)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+var (
+	createTypeRe = regexp.MustCompile(`(?i)CREATE\s+TYPE\s+(\S+)\s+AS\s+ENUM\s*\(((?:[^')]|'(?:[^']|'')*')*)\)\s*;`)
+	literalRe    = regexp.MustCompile(`'((?:[^']|'')*)'`)
+)
+
+// readSnapshot reads CREATE TYPE statements in the file and returns the values for each type and the order of types.
+// It returns empty if the snapshot file doesn't exist.
+func readSnapshot(path string) (map[string][]string, []string, error) {
+	types := make(map[string][]string)
+	var order []string
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return types, order, nil
+		}
+		return nil, nil, err
+	}
+	for _, m := range createTypeRe.FindAllStringSubmatch(string(contents), -1) {
+		var values []string
+		for _, v := range literalRe.FindAllStringSubmatch(m[2], -1) {
+			values = append(values, strings.ReplaceAll(v[1], "''", "'"))
+		}
+		types[m[1]] = values
+		order = append(order, m[1])
+	}
+	return types, order, nil
+}
+
+func typeName(e enum.EnumType) string {
+	return helper.ToSnakeCase(e.Name)
+}
+
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func quoteAll(list []string) []string {
+	var quoted []string
+	for _, s := range list {
+		quoted = append(quoted, quote(s))
+	}
+	return quoted
+}
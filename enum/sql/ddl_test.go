@@ -0,0 +1,210 @@
+package sql
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+func TestDDL_Generate(t *testing.T) {
+	enums := []enum.EnumType{
+		{
+			Name: "MyEnum",
+			Kind: enum.EnumKindString,
+			Keys: []enum.EnumKey{
+				{GoName: "MyEnumValueA", Name: "ValueA", Value: `"value_a"`},
+				{GoName: "MyEnumValueB", Name: "ValueB", Value: `"it's"`},
+				{GoName: "MyEnumValueAlias", Name: "ValueAlias", Value: `"value_a"`},
+			},
+		},
+		{
+			Name: "Priority",
+			Kind: enum.EnumKindInteger,
+			Keys: []enum.EnumKey{
+				{GoName: "PriorityLow", Name: "Low", Value: "0"},
+				{GoName: "PriorityHigh", Name: "High", Value: "1"},
+			},
+		},
+	}
+	cases := []struct {
+		name     string
+		enums    []enum.EnumType
+		options  []Option
+		filename string
+		output   string
+		isError  bool
+	}{
+		{
+			name:     "Postgres",
+			enums:    enums[0:1],
+			filename: "enums.postgres.sql",
+			output: `CREATE TYPE my_enum AS ENUM ('value_a', 'it''s');
+`,
+		},
+		{
+			name:     "MySQL",
+			enums:    enums[0:1],
+			options:  []Option{WithDialect(DialectMySQL)},
+			filename: "enums.mysql.sql",
+			output: `-- MyEnum
+ENUM('value_a', 'it''s')
+`,
+		},
+		{
+			name:     "ByName",
+			enums:    enums,
+			options:  []Option{StoreBy(StorageName), Filename("schema/enums.sql")},
+			filename: "schema/enums.sql",
+			output: `CREATE TYPE my_enum AS ENUM ('ValueA', 'ValueB', 'ValueAlias');
+
+CREATE TYPE priority AS ENUM ('Low', 'High');
+`,
+		},
+		{
+			name:    "IntegerByValue",
+			enums:   enums[1:],
+			isError: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			var buff bytes.Buffer
+			g := NewDDLGenerator(c.options...)
+			err := g.Generate(&buff, c.enums)
+			if c.isError {
+				if err == nil {
+					tt.Errorf("expected an error, got: %s", buff.String())
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if g.Filename() != c.filename {
+				tt.Errorf("expected: %s, got: %s", c.filename, g.Filename())
+			}
+			if buff.String() != c.output {
+				tt.Errorf("expected: %s, got: %s", c.output, buff.String())
+			}
+		})
+	}
+}
+
+func TestDDL_Migration(t *testing.T) {
+	dir := t.TempDir()
+	err := ioutil.WriteFile(filepath.Join(dir, "enums.postgres.sql"), []byte(`CREATE TYPE my_enum AS ENUM ('value_a', 'value_c');
+
+CREATE TYPE old_enum AS ENUM ('it''s');
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	myEnum := enum.EnumType{
+		Name: "MyEnum",
+		Kind: enum.EnumKindString,
+		Keys: []enum.EnumKey{
+			{GoName: "MyEnumValueA", Name: "ValueA", Value: `"value_a"`},
+			{GoName: "MyEnumValueB", Name: "ValueB", Value: `"value_b"`},
+			{GoName: "MyEnumValueC", Name: "ValueC", Value: `"value_c"`},
+		},
+	}
+	newEnum := enum.EnumType{
+		Name: "NewEnum",
+		Kind: enum.EnumKindString,
+		Keys: []enum.EnumKey{
+			{GoName: "NewEnumX", Name: "X", Value: `"x"`},
+		},
+	}
+	cases := []struct {
+		name    string
+		enums   []enum.EnumType
+		options []Option
+		output  string
+		isError bool
+	}{
+		{
+			name:    "RemovedType",
+			enums:   []enum.EnumType{myEnum, newEnum},
+			isError: true,
+		},
+		{
+			name:    "RemovedTypeWithForce",
+			enums:   []enum.EnumType{myEnum, newEnum},
+			options: []Option{Force()},
+			output: `ALTER TYPE my_enum ADD VALUE 'value_b' AFTER 'value_a';
+CREATE TYPE new_enum AS ENUM ('x');
+DROP TYPE old_enum;
+`,
+		},
+		{
+			name: "RemovedValue",
+			enums: []enum.EnumType{
+				{
+					Name: "MyEnum",
+					Kind: enum.EnumKindString,
+					Keys: myEnum.Keys[0:1],
+				},
+				{
+					Name: "OldEnum",
+					Kind: enum.EnumKindString,
+					Keys: []enum.EnumKey{{GoName: "OldEnumIts", Name: "Its", Value: `"it's"`}},
+				},
+			},
+			isError: true,
+		},
+		{
+			name: "RemovedValueWithForce",
+			enums: []enum.EnumType{
+				{
+					Name: "MyEnum",
+					Kind: enum.EnumKindString,
+					Keys: myEnum.Keys[0:1],
+				},
+				{
+					Name: "OldEnum",
+					Kind: enum.EnumKindString,
+					Keys: []enum.EnumKey{{GoName: "OldEnumIts", Name: "Its", Value: `"it's"`}},
+				},
+			},
+			options: []Option{Force()},
+			output: `-- 'value_c' is removed from my_enum but PostgreSQL cannot drop enum values
+`,
+		},
+		{
+			name: "ByName",
+			enums: []enum.EnumType{
+				{
+					Name: "OldEnum",
+					Kind: enum.EnumKindInteger,
+					Keys: []enum.EnumKey{{GoName: "OldEnumIts", Name: "it's", Value: "0"}},
+				},
+			},
+			options: []Option{StoreBy(StorageName), Force()},
+			output: `DROP TYPE my_enum;
+`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			var buff bytes.Buffer
+			// the snapshot is relative to the package directory
+			g := NewMigrationGenerator("enums.postgres.sql", c.options...).(enum.DirGenerator)
+			err := g.GenerateInDir(&buff, dir, c.enums)
+			if c.isError {
+				if err == nil {
+					tt.Errorf("expected an error, got: %s", buff.String())
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if buff.String() != c.output {
+				tt.Errorf("expected: %s, got: %s", c.output, buff.String())
+			}
+		})
+	}
+}
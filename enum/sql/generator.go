@@ -0,0 +1,246 @@
+package sql
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename = "sql_enums.go"
+)
+
+// Storage specifies which representation of the enum key is stored in the database.
+type Storage string
+
+const (
+	// StorageValue stores the value of the Go constant.
+	StorageValue = Storage("value")
+	// StorageName stores EnumKey.Name, which ent requires for non string enums.
+	StorageName = Storage("name")
+)
+
+// config is shared by the generators in this package. Options not relevant to a generator are ignored.
+type config struct {
+	storage       Storage
+	dialect       Dialect
+	filename      string
+	force         bool
+	reportUnknown string
+}
+
+type Option func(*config) *config
+
+// StoreBy configures the representation stored in the database. StorageValue is used by default.
+func StoreBy(s Storage) Option {
+	return func(c *config) *config {
+		c.storage = s
+		return c
+	}
+}
+
+// ReportUnknown configures the name of the function called with the type name and the raw value
+// when Scan() maps an unknown value to the key declared by `enum:unknown`. The function must be declared
+// in the package as `func(typeName string, raw interface{})`.
+func ReportUnknown(funcName string) Option {
+	return func(c *config) *config {
+		c.reportUnknown = funcName
+		return c
+	}
+}
+
+func newConfig(options []Option) *config {
+	c := &config{
+		storage: StorageValue,
+		dialect: DialectPostgres,
+	}
+	for _, opts := range options {
+		c = opts(c)
+	}
+	return c
+}
+
+type generator struct {
+	*config
+}
+
+// NewGenerator returns a generator to write Scan() and Value() of enums.
+func NewGenerator(options ...Option) enum.Generator {
+	return &generator{
+		config: newConfig(options),
+	}
+}
+
+func (g *generator) Filename() string {
+	return generatedFilename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	for _, e := range enums {
+		if g.storage == StorageValue && e.Kind != enum.EnumKindString && e.Kind != enum.EnumKindInteger {
+			return fmt.Errorf("%s: %s enum cannot be stored by value", e.Name, e.Kind)
+		}
+		if g.storage == StorageName && e.Flags {
+			return fmt.Errorf("%s: bit flags cannot be stored by name", e.Name)
+		}
+	}
+	fmt.Fprintf(out, "import (\n")
+	fmt.Fprintf(out, "\t\"database/sql/driver\"\n")
+	fmt.Fprintf(out, "\t\"fmt\"\n")
+	for _, e := range enums {
+		if g.isInteger(e) {
+			fmt.Fprintf(out, "\t\"strconv\"\n")
+			break
+		}
+	}
+	fmt.Fprintf(out, ")\n")
+	fmt.Fprintf(out, "\n")
+	for _, e := range enums {
+		unknown, err := e.UnknownKey()
+		if err != nil {
+			return err
+		}
+		if g.storage == StorageName {
+			g.writeScanByName(e, unknown, out)
+			fmt.Fprint(out, "\n")
+			g.writeValueByName(e, out)
+			fmt.Fprint(out, "\n")
+			continue
+		}
+		g.writeScan(e, unknown, out)
+		fmt.Fprint(out, "\n")
+		g.writeValue(e, out)
+		fmt.Fprint(out, "\n")
+	}
+	return nil
+}
+
+func (g *generator) writeScan(e enum.EnumType, unknown *enum.EnumKey, w io.Writer) {
+	fmt.Fprintf(w, "func (e *%s) Scan(src interface{}) error {\n", e.Name)
+	if g.isInteger(e) {
+		fmt.Fprintf(w, "\tvar v int64\n")
+		fmt.Fprintf(w, "\tswitch s := src.(type) {\n")
+		fmt.Fprintf(w, "\tcase int64:\n")
+		fmt.Fprintf(w, "\t\tv = s\n")
+		fmt.Fprintf(w, "\tcase []byte:\n")
+		fmt.Fprintf(w, "\t\ti, err := strconv.ParseInt(string(s), 10, 64)\n")
+		fmt.Fprintf(w, "\t\tif err != nil {\n")
+		fmt.Fprintf(w, "\t\t\treturn fmt.Errorf(\"invalid %s value: %%q\", s)\n", e.Name)
+		fmt.Fprintf(w, "\t\t}\n")
+		fmt.Fprintf(w, "\t\tv = i\n")
+		fmt.Fprintf(w, "\tcase string:\n")
+		fmt.Fprintf(w, "\t\ti, err := strconv.ParseInt(s, 10, 64)\n")
+		fmt.Fprintf(w, "\t\tif err != nil {\n")
+		fmt.Fprintf(w, "\t\t\treturn fmt.Errorf(\"invalid %s value: %%q\", s)\n", e.Name)
+		fmt.Fprintf(w, "\t\t}\n")
+		fmt.Fprintf(w, "\t\tv = i\n")
+	} else {
+		fmt.Fprintf(w, "\tvar v string\n")
+		fmt.Fprintf(w, "\tswitch s := src.(type) {\n")
+		fmt.Fprintf(w, "\tcase string:\n")
+		fmt.Fprintf(w, "\t\tv = s\n")
+		fmt.Fprintf(w, "\tcase []byte:\n")
+		fmt.Fprintf(w, "\t\tv = string(s)\n")
+	}
+	fmt.Fprintf(w, "\tdefault:\n")
+	fmt.Fprintf(w, "\t\treturn fmt.Errorf(\"cannot scan %%T into %s\", src)\n", e.Name)
+	fmt.Fprintf(w, "\t}\n")
+	if e.Flags {
+		// any combination of the flags is valid.
+		fmt.Fprintf(w, "\tif %s(v)&^(%s) == 0 {\n", e.Name, e.FlagMask())
+	} else {
+		fmt.Fprintf(w, "\tswitch %s(v) {\n", e.Name)
+		fmt.Fprintf(w, "\tcase %s:\n", strings.Join(goNames(e), ", "))
+	}
+	fmt.Fprintf(w, "\t\t*e = %s(v)\n", e.Name)
+	fmt.Fprintf(w, "\t\treturn nil\n")
+	fmt.Fprintf(w, "\t}\n")
+	if g.writeScanUnknown(e, unknown, w) {
+		return
+	}
+	fmt.Fprintf(w, "\treturn fmt.Errorf(\"invalid %s value: %%v\", v)\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeValue(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "func (e %s) Value() (driver.Value, error) {\n", e.Name)
+	if e.Flags {
+		fmt.Fprintf(w, "\tif e&^(%s) == 0 {\n", e.FlagMask())
+	} else {
+		fmt.Fprintf(w, "\tswitch e {\n")
+		fmt.Fprintf(w, "\tcase %s:\n", strings.Join(goNames(e), ", "))
+	}
+	if g.isInteger(e) {
+		fmt.Fprintf(w, "\t\treturn int64(e), nil\n")
+	} else {
+		fmt.Fprintf(w, "\t\treturn string(e), nil\n")
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn nil, fmt.Errorf(\"invalid %s value: %%#v\", e)\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeScanByName(e enum.EnumType, unknown *enum.EnumKey, w io.Writer) {
+	fmt.Fprintf(w, "func (e *%s) Scan(src interface{}) error {\n", e.Name)
+	fmt.Fprintf(w, "\tvar v string\n")
+	fmt.Fprintf(w, "\tswitch s := src.(type) {\n")
+	fmt.Fprintf(w, "\tcase string:\n")
+	fmt.Fprintf(w, "\t\tv = s\n")
+	fmt.Fprintf(w, "\tcase []byte:\n")
+	fmt.Fprintf(w, "\t\tv = string(s)\n")
+	fmt.Fprintf(w, "\tdefault:\n")
+	fmt.Fprintf(w, "\t\treturn fmt.Errorf(\"cannot scan %%T into %s\", src)\n", e.Name)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tswitch v {\n")
+	for _, c := range e.Keys {
+		fmt.Fprintf(w, "\tcase %q:\n", c.Name)
+		fmt.Fprintf(w, "\t\t*e = %s\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn nil\n")
+	}
+	fmt.Fprintf(w, "\t}\n")
+	if g.writeScanUnknown(e, unknown, w) {
+		return
+	}
+	fmt.Fprintf(w, "\treturn fmt.Errorf(\"invalid %s value: %%q\", v)\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeValueByName(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "func (e %s) Value() (driver.Value, error) {\n", e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, c := range e.DistinctKeys() {
+		fmt.Fprintf(w, "\tcase %s:\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn %q, nil\n", c.Name)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn nil, fmt.Errorf(\"invalid %s value: %%#v\", e)\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+}
+
+// writeScanUnknown writes the end of Scan() mapping v to the unknown key. It returns false if there is no unknown key.
+func (g *generator) writeScanUnknown(e enum.EnumType, unknown *enum.EnumKey, w io.Writer) bool {
+	if unknown == nil {
+		return false
+	}
+	fmt.Fprintf(w, "\t*e = %s\n", unknown.GoName)
+	if g.reportUnknown != "" {
+		fmt.Fprintf(w, "\t%s(%q, v)\n", g.reportUnknown, e.Name)
+	}
+	fmt.Fprintf(w, "\treturn nil\n")
+	fmt.Fprintf(w, "}\n")
+	return true
+}
+
+func goNames(e enum.EnumType) []string {
+	var names []string
+	for _, c := range e.DistinctKeys() {
+		names = append(names, c.GoName)
+	}
+	return names
+}
+
+func (g *generator) isInteger(e enum.EnumType) bool {
+	return g.storage == StorageValue && e.Kind == enum.EnumKindInteger
+}
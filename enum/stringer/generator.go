@@ -0,0 +1,91 @@
+package stringer
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename = "stringer_enums.go"
+)
+
+type generator struct {
+	nameFunc func(enum.EnumKey) string
+}
+
+type Option func(*generator) *generator
+
+// NameFunc configures the string representation of each key. EnumKey.Name is used by default.
+func NameFunc(f func(enum.EnumKey) string) Option {
+	return func(g *generator) *generator {
+		g.nameFunc = f
+		return g
+	}
+}
+
+func NewGenerator(options ...Option) enum.Generator {
+	g := &generator{
+		nameFunc: func(k enum.EnumKey) string {
+			return k.Name
+		},
+	}
+	for _, opts := range options {
+		g = opts(g)
+	}
+	return g
+}
+
+func (g *generator) Filename() string {
+	return generatedFilename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	fmt.Fprintf(out, "import (\n")
+	fmt.Fprintf(out, "\t\"fmt\"\n")
+	fmt.Fprintf(out, ")\n")
+	fmt.Fprintf(out, "\n")
+	for _, e := range enums {
+		if e.Flags {
+			// String() of bit flags is generated by enum/bitflag
+			continue
+		}
+		g.writeString(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeFromString(e, out)
+		fmt.Fprint(out, "\n")
+	}
+	return nil
+}
+
+func (g *generator) writeString(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "func (e %s) String() string {\n", e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, c := range e.DistinctKeys() {
+		fmt.Fprintf(w, "\tcase %s:\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn %q\n", g.nameFunc(c))
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn fmt.Sprintf(\"%s(%%#v)\", e)\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeFromString(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "func %sFromString(s string) (%s, bool) {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\tswitch s {\n")
+	seen := make(map[string]bool)
+	for _, c := range e.Keys {
+		name := g.nameFunc(c)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		fmt.Fprintf(w, "\tcase %q:\n", name)
+		fmt.Fprintf(w, "\t\treturn %s, true\n", c.GoName)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tvar zero %s\n", e.Name)
+	fmt.Fprintf(w, "\treturn zero, false\n")
+	fmt.Fprintf(w, "}\n")
+}
@@ -0,0 +1,207 @@
+package text
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename = "text_enums.go"
+)
+
+// Encoding specifies which representation of the enum key is used in MarshalText()/UnmarshalText().
+type Encoding string
+
+const (
+	// EncodingName uses EnumKey.Name, which is the same as the GraphQL enum value.
+	EncodingName = Encoding("name")
+	// EncodingValue uses the value of the Go constant, which keeps the representation of integer enums
+	// as map keys in encoding/json.
+	EncodingValue = Encoding("value")
+)
+
+type generator struct {
+	encoding   Encoding
+	omitString bool
+}
+
+type Option func(*generator) *generator
+
+// EncodeBy configures the representation of MarshalText()/UnmarshalText(). EncodingName is used by default.
+// Set() and String() always use the names for command line flags.
+func EncodeBy(e Encoding) Option {
+	return func(g *generator) *generator {
+		g.encoding = e
+		return g
+	}
+}
+
+// OmitString configures the generator not to write String() so that it can be used with enum/stringer.
+func OmitString() Option {
+	return func(g *generator) *generator {
+		g.omitString = true
+		return g
+	}
+}
+
+// NewGenerator returns a generator to write MarshalText()/UnmarshalText() for encoding.TextMarshaler/TextUnmarshaler
+// and Set()/String()/Type() for flag.Value and pflag.Value. Bit flag enums are ignored.
+func NewGenerator(options ...Option) enum.Generator {
+	g := &generator{
+		encoding: EncodingName,
+	}
+	for _, opts := range options {
+		g = opts(g)
+	}
+	return g
+}
+
+func (g *generator) Filename() string {
+	return generatedFilename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	var list []enum.EnumType
+	for _, e := range enums {
+		// Set() and String() of bit flags cannot be represented by a single name.
+		if !e.Flags {
+			list = append(list, e)
+		}
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	if g.encoding != EncodingName && g.encoding != EncodingValue {
+		return fmt.Errorf("unknown encoding: %s", g.encoding)
+	}
+	fmt.Fprintf(out, "import (\n")
+	fmt.Fprintf(out, "\t\"fmt\"\n")
+	fmt.Fprintf(out, ")\n")
+	fmt.Fprintf(out, "\n")
+	for _, e := range list {
+		g.writeUsage(e, out)
+		fmt.Fprint(out, "\n")
+		if err := g.writeMarshalText(e, out); err != nil {
+			return err
+		}
+		fmt.Fprint(out, "\n")
+		if err := g.writeUnmarshalText(e, out); err != nil {
+			return err
+		}
+		fmt.Fprint(out, "\n")
+		g.writeSet(e, out)
+		fmt.Fprint(out, "\n")
+		if !g.omitString {
+			g.writeString(e, out)
+			fmt.Fprint(out, "\n")
+		}
+		g.writeType(e, out)
+		fmt.Fprint(out, "\n")
+	}
+	return nil
+}
+
+func (g *generator) writeUsage(e enum.EnumType, w io.Writer) {
+	var names []string
+	for _, c := range e.DistinctKeys() {
+		names = append(names, c.Name)
+	}
+	fmt.Fprintf(w, "// %sUsage lists the allowed values of %s for flag usages and error messages.\n", e.Name, e.Name)
+	fmt.Fprintf(w, "const %sUsage = %q\n", e.Name, "one of "+strings.Join(names, ", "))
+}
+
+func (g *generator) writeMarshalText(e enum.EnumType, w io.Writer) error {
+	fmt.Fprintf(w, "func (e %s) MarshalText() ([]byte, error) {\n", e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, c := range e.DistinctKeys() {
+		text, err := g.text(e, c)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "\tcase %s:\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn []byte(%q), nil\n", text)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn nil, fmt.Errorf(\"invalid %s value: %%#v\", e)\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+	return nil
+}
+
+func (g *generator) writeUnmarshalText(e enum.EnumType, w io.Writer) error {
+	fmt.Fprintf(w, "func (e *%s) UnmarshalText(text []byte) error {\n", e.Name)
+	if g.encoding == EncodingName {
+		fmt.Fprintf(w, "\treturn e.Set(string(text))\n")
+		fmt.Fprintf(w, "}\n")
+		return nil
+	}
+	fmt.Fprintf(w, "\tswitch string(text) {\n")
+	for _, c := range e.DistinctKeys() {
+		text, err := g.text(e, c)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "\tcase %q:\n", text)
+		fmt.Fprintf(w, "\t\t*e = %s\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn nil\n")
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn fmt.Errorf(\"invalid %s value: %%q\", text)\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+	return nil
+}
+
+// text returns the text representation of the key in the configured encoding.
+func (g *generator) text(e enum.EnumType, c enum.EnumKey) (string, error) {
+	if g.encoding == EncodingName {
+		return c.Name, nil
+	}
+	if e.Kind == enum.EnumKindString {
+		v, err := strconv.Unquote(c.Value)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", c.GoName, err)
+		}
+		return v, nil
+	}
+	return c.Value, nil
+}
+
+func (g *generator) writeSet(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "func (e *%s) Set(s string) error {\n", e.Name)
+	fmt.Fprintf(w, "\tswitch s {\n")
+	seen := make(map[string]bool)
+	for _, c := range e.Keys {
+		if seen[c.Name] {
+			continue
+		}
+		seen[c.Name] = true
+		fmt.Fprintf(w, "\tcase %q:\n", c.Name)
+		fmt.Fprintf(w, "\t\t*e = %s\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn nil\n")
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn fmt.Errorf(\"invalid %s value: %%q, must be %%s\", s, %sUsage)\n", e.Name, e.Name)
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeString(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "func (e %s) String() string {\n", e.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, c := range e.DistinctKeys() {
+		fmt.Fprintf(w, "\tcase %s:\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn %q\n", c.Name)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn fmt.Sprintf(\"%s(%%#v)\", e)\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeType(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "// Type returns the type name shown in pflag usages.\n")
+	fmt.Fprintf(w, "func (e %s) Type() string {\n", e.Name)
+	fmt.Fprintf(w, "\treturn %q\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+}
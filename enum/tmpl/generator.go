@@ -0,0 +1,129 @@
+package tmpl
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/yssk22/go-generators/enum"
+	"github.com/yssk22/go-generators/helper"
+)
+
+const (
+	// templateFilename is the name of the template to render the output filename.
+	templateFilename = "filename"
+	// templateImports is the name of the template to render the import paths of the Go file, one per line.
+	templateImports = "imports"
+)
+
+// DefaultFuncs are the functions available in templates.
+var DefaultFuncs = template.FuncMap{
+	"snake":      helper.ToSnakeCase,
+	"lowerCamel": helper.ToLowerCamleCase,
+	"quote":      strconv.Quote,
+	"upper":      strings.ToUpper,
+}
+
+type generator struct {
+	path     string
+	filename string
+	funcs    template.FuncMap
+	tmpl     *template.Template
+}
+
+type Option func(*generator) *generator
+
+// Filename configures the path of the generated file, which takes precedence over the "filename" template.
+func Filename(name string) Option {
+	return func(g *generator) *generator {
+		g.filename = name
+		return g
+	}
+}
+
+// Funcs adds the functions available in the template.
+func Funcs(funcs template.FuncMap) Option {
+	return func(g *generator) *generator {
+		for name, f := range funcs {
+			g.funcs[name] = f
+		}
+		return g
+	}
+}
+
+// NewGenerator returns a generator to render the text/template file against []enum.EnumType.
+//
+// The template can define "filename" to render the output filename, otherwise the template filename without
+// .tmpl extension is used. For Go files, the template can also define "imports" to render the import paths
+// one per line, which are written in the import declaration after the package clause.
+func NewGenerator(path string, options ...Option) (enum.Generator, error) {
+	g := &generator{
+		path:  path,
+		funcs: template.FuncMap{},
+	}
+	for name, f := range DefaultFuncs {
+		g.funcs[name] = f
+	}
+	for _, opts := range options {
+		g = opts(g)
+	}
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	g.tmpl, err = template.New(filepath.Base(path)).Funcs(g.funcs).Parse(string(text))
+	if err != nil {
+		return nil, err
+	}
+	if g.filename == "" {
+		g.filename = strings.TrimSuffix(filepath.Base(path), ".tmpl")
+		if t := g.tmpl.Lookup(templateFilename); t != nil {
+			var buff bytes.Buffer
+			if err := t.Execute(&buff, nil); err != nil {
+				return nil, fmt.Errorf("cannot render the filename: %w", err)
+			}
+			g.filename = strings.TrimSpace(buff.String())
+		}
+	}
+	if g.filename == "" {
+		return nil, fmt.Errorf("%s: empty filename", path)
+	}
+	return g, nil
+}
+
+func (g *generator) Filename() string {
+	return g.filename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	if t := g.tmpl.Lookup(templateImports); t != nil {
+		var buff bytes.Buffer
+		if err := t.Execute(&buff, enums); err != nil {
+			return err
+		}
+		var imports []string
+		for _, line := range strings.Split(buff.String(), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				imports = append(imports, line)
+			}
+		}
+		if len(imports) > 0 {
+			fmt.Fprintf(out, "import (\n")
+			for _, path := range imports {
+				// quote the bare path but keep the named import like `pb "example.com/pb"` as is
+				if !strings.Contains(path, "\"") {
+					path = strconv.Quote(path)
+				}
+				fmt.Fprintf(out, "\t%s\n", path)
+			}
+			fmt.Fprintf(out, ")\n")
+			fmt.Fprintf(out, "\n")
+		}
+	}
+	return g.tmpl.Execute(out, enums)
+}
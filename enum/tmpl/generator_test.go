@@ -0,0 +1,71 @@
+package tmpl
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+func TestGenerator(t *testing.T) {
+	enums := []enum.EnumType{
+		{
+			Name: "MyEnum",
+			Kind: enum.EnumKindString,
+			Keys: []enum.EnumKey{
+				{GoName: "MyEnumValueA", Name: "ValueA", Value: `"value_a"`},
+			},
+		},
+	}
+	cases := []struct {
+		name     string
+		template string
+		options  []Option
+		filename string
+		expect   string
+	}{
+		{
+			name:     "Default",
+			template: `{{range .}}{{.Name | snake}}:{{range .Keys}} {{.Name | lowerCamel | quote}}={{.Value}}{{end}}{{end}}`,
+			filename: "enums.txt",
+			expect:   `my_enum: "valueA"="value_a"`,
+		},
+		{
+			name:     "FilenameAndImports",
+			template: `{{define "filename"}}{{"avro" | upper}}_enums.go{{end}}{{define "imports"}}fmt{{"\n"}}pb "example.com/pb"{{end}}var _ = fmt.Sprint`,
+			filename: "AVRO_enums.go",
+			expect:   "import (\n\t\"fmt\"\n\tpb \"example.com/pb\"\n)\n\nvar _ = fmt.Sprint",
+		},
+		{
+			name:     "FilenameOption",
+			template: `{{define "filename"}}ignored.go{{end}}`,
+			options:  []Option{Filename("../enums.avsc")},
+			filename: "../enums.avsc",
+			expect:   "",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			path := filepath.Join(tt.TempDir(), "enums.txt.tmpl")
+			if err := os.WriteFile(path, []byte(c.template), 0644); err != nil {
+				tt.Fatal(err)
+			}
+			g, err := NewGenerator(path, c.options...)
+			if err != nil {
+				tt.Fatalf("cannot create generator: %v", err)
+			}
+			if g.Filename() != c.filename {
+				tt.Errorf("expected: %v, got: %v", c.filename, g.Filename())
+			}
+			var buff bytes.Buffer
+			if err := g.Generate(&buff, enums); err != nil {
+				tt.Fatalf("cannot generate: %v", err)
+			}
+			if buff.String() != c.expect {
+				tt.Errorf("expected: %q, got: %q", c.expect, buff.String())
+			}
+		})
+	}
+}
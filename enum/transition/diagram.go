@@ -0,0 +1,70 @@
+package transition
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedDiagramFilename = "transitions.dot"
+)
+
+type diagramGenerator struct {
+	filename string
+}
+
+type DiagramOption func(*diagramGenerator) *diagramGenerator
+
+// Filename configures the path of the diagram.
+func Filename(name string) DiagramOption {
+	return func(g *diagramGenerator) *diagramGenerator {
+		g.filename = name
+		return g
+	}
+}
+
+// NewDiagramGenerator returns a generator to write a Graphviz diagram of the transitions with a cluster per enum.
+func NewDiagramGenerator(options ...DiagramOption) enum.Generator {
+	g := &diagramGenerator{
+		filename: generatedDiagramFilename,
+	}
+	for _, opts := range options {
+		g = opts(g)
+	}
+	return g
+}
+
+func (g *diagramGenerator) Filename() string {
+	return g.filename
+}
+
+func (g *diagramGenerator) Generate(out io.Writer, enums []enum.EnumType) error {
+	var list []*stateMachine
+	for _, e := range enums {
+		sm, err := newStateMachine(e)
+		if err != nil {
+			return err
+		}
+		if sm != nil {
+			list = append(list, sm)
+		}
+	}
+	fmt.Fprintf(out, "digraph enums {\n")
+	for _, sm := range list {
+		fmt.Fprintf(out, "\tsubgraph %q {\n", "cluster_"+sm.Name)
+		fmt.Fprintf(out, "\t\tlabel=%q;\n", sm.Name)
+		for _, state := range sm.states {
+			fmt.Fprintf(out, "\t\t%q [label=%q];\n", state.GoName, state.Name)
+		}
+		for _, from := range sm.states {
+			for _, to := range sm.transitions[from.GoName] {
+				fmt.Fprintf(out, "\t\t%q -> %q;\n", from.GoName, to.GoName)
+			}
+		}
+		fmt.Fprintf(out, "\t}\n")
+	}
+	fmt.Fprintf(out, "}\n")
+	return nil
+}
@@ -0,0 +1,118 @@
+package transition
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename = "transition_enums.go"
+)
+
+type generator struct {
+}
+
+// NewGenerator returns a generator to write the guards of the transitions declared by `enum:next` directives.
+// Enums without transitions are ignored.
+func NewGenerator() enum.Generator {
+	return &generator{}
+}
+
+func (g *generator) Filename() string {
+	return generatedFilename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	var list []*stateMachine
+	for _, e := range enums {
+		sm, err := newStateMachine(e)
+		if err != nil {
+			return err
+		}
+		if sm != nil {
+			list = append(list, sm)
+		}
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	fmt.Fprintf(out, "import (\n")
+	fmt.Fprintf(out, "\t\"errors\"\n")
+	fmt.Fprintf(out, "\t\"fmt\"\n")
+	fmt.Fprintf(out, ")\n")
+	fmt.Fprintf(out, "\n")
+	for _, sm := range list {
+		g.writeError(sm, out)
+		fmt.Fprint(out, "\n")
+		g.writeAllowedTransitions(sm, out)
+		fmt.Fprint(out, "\n")
+		g.writeCanTransitionTo(sm, out)
+		fmt.Fprint(out, "\n")
+		g.writeTransitionTo(sm, out)
+		fmt.Fprint(out, "\n")
+	}
+	return nil
+}
+
+func (g *generator) writeError(sm *stateMachine, w io.Writer) {
+	fmt.Fprintf(w, "// ErrInvalid%sTransition is returned when the transition is not allowed.\n", sm.Name)
+	fmt.Fprintf(w, "var ErrInvalid%sTransition = errors.New(\"invalid %s transition\")\n", sm.Name, sm.Name)
+}
+
+func (g *generator) writeAllowedTransitions(sm *stateMachine, w io.Writer) {
+	fmt.Fprintf(w, "// AllowedTransitions returns the values e can transition to.\n")
+	fmt.Fprintf(w, "func (e %s) AllowedTransitions() []%s {\n", sm.Name, sm.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, from := range sm.states {
+		to := sm.transitions[from.GoName]
+		if len(to) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\tcase %s:\n", from.GoName)
+		fmt.Fprintf(w, "\t\treturn []%s{%s}\n", sm.Name, strings.Join(goNames(to), ", "))
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn nil\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeCanTransitionTo(sm *stateMachine, w io.Writer) {
+	fmt.Fprintf(w, "// CanTransitionTo returns true if e can transition to next.\n")
+	fmt.Fprintf(w, "func (e %s) CanTransitionTo(next %s) bool {\n", sm.Name, sm.Name)
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, from := range sm.states {
+		to := sm.transitions[from.GoName]
+		if len(to) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\tcase %s:\n", from.GoName)
+		fmt.Fprintf(w, "\t\tswitch next {\n")
+		fmt.Fprintf(w, "\t\tcase %s:\n", strings.Join(goNames(to), ", "))
+		fmt.Fprintf(w, "\t\t\treturn true\n")
+		fmt.Fprintf(w, "\t\t}\n")
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn false\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeTransitionTo(sm *stateMachine, w io.Writer) {
+	fmt.Fprintf(w, "// TransitionTo returns next if e can transition to next, otherwise returns e with an error wrapping ErrInvalid%sTransition.\n", sm.Name)
+	fmt.Fprintf(w, "func (e %s) TransitionTo(next %s) (%s, error) {\n", sm.Name, sm.Name, sm.Name)
+	fmt.Fprintf(w, "\tif !e.CanTransitionTo(next) {\n")
+	fmt.Fprintf(w, "\t\treturn e, fmt.Errorf(\"%%w: from %%v to %%v\", ErrInvalid%sTransition, e, next)\n", sm.Name)
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn next, nil\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func goNames(keys []enum.EnumKey) []string {
+	var names []string
+	for _, k := range keys {
+		names = append(names, k.GoName)
+	}
+	return names
+}
@@ -0,0 +1,66 @@
+package transition
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+// stateMachine is the transitions of an enum between the distinct keys.
+type stateMachine struct {
+	enum.EnumType
+	states      []enum.EnumKey
+	transitions map[string][]enum.EnumKey // keyed by GoName of the source key
+}
+
+// newStateMachine resolves `enum:next` directives of the enum. It returns nil if the enum has no transitions.
+// The target can be the name of the key, the Go name of the constant, or the Go name without the type name prefix.
+func newStateMachine(e enum.EnumType) (*stateMachine, error) {
+	states := e.DistinctKeys()
+	byValue := make(map[string]enum.EnumKey)
+	byName := make(map[string]enum.EnumKey)
+	for _, k := range states {
+		byValue[k.Value] = k
+	}
+	for _, k := range e.Keys {
+		state := byValue[k.Value]
+		for _, name := range []string{k.Name, k.GoName, strings.TrimPrefix(k.GoName, e.Name)} {
+			if _, ok := byName[name]; !ok {
+				byName[name] = state
+			}
+		}
+	}
+	sm := &stateMachine{
+		EnumType:    e,
+		states:      states,
+		transitions: make(map[string][]enum.EnumKey),
+	}
+	hasTransition := false
+	for _, k := range e.Keys {
+		from := byValue[k.Value]
+		for _, name := range k.Next {
+			to, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("%s: unknown transition from %s to %s", e.Name, k.GoName, name)
+			}
+			if !containsKey(sm.transitions[from.GoName], to) {
+				sm.transitions[from.GoName] = append(sm.transitions[from.GoName], to)
+			}
+			hasTransition = true
+		}
+	}
+	if !hasTransition {
+		return nil, nil
+	}
+	return sm, nil
+}
+
+func containsKey(keys []enum.EnumKey, key enum.EnumKey) bool {
+	for _, k := range keys {
+		if k.GoName == key.GoName {
+			return true
+		}
+	}
+	return false
+}
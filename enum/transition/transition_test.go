@@ -0,0 +1,72 @@
+package transition
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+func TestTransition_newStateMachine(t *testing.T) {
+	status := enum.EnumType{
+		Name: "Status",
+		Keys: []enum.EnumKey{
+			{GoName: "StatusPending", Name: "PENDING", Value: `"pending"`, Next: []string{"SHIPPED", "StatusCancelled", "Canceled"}},
+			{GoName: "StatusShipped", Name: "SHIPPED", Value: `"shipped"`},
+			{GoName: "StatusCancelled", Name: "CANCELLED", Value: `"cancelled"`},
+			{GoName: "StatusCanceled", Name: "CANCELED", Value: `"cancelled"`, Next: []string{"Pending"}},
+		},
+	}
+	cases := []struct {
+		name        string
+		keys        []enum.EnumKey
+		transitions map[string][]string
+		err         bool
+	}{
+		{
+			name: "Resolved",
+			keys: status.Keys,
+			transitions: map[string][]string{
+				"StatusPending":   {"StatusShipped", "StatusCancelled"},
+				"StatusCancelled": {"StatusPending"},
+			},
+		},
+		{
+			name: "Unknown",
+			keys: []enum.EnumKey{
+				{GoName: "StatusPending", Name: "PENDING", Value: `"pending"`, Next: []string{"Delivered"}},
+			},
+			err: true,
+		},
+		{
+			name: "NoTransitions",
+			keys: []enum.EnumKey{
+				{GoName: "StatusPending", Name: "PENDING", Value: `"pending"`},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			sm, err := newStateMachine(enum.EnumType{Name: status.Name, Keys: c.keys})
+			if c.err {
+				if err == nil {
+					tt.Errorf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			var got map[string][]string
+			if sm != nil {
+				got = make(map[string][]string)
+				for from, to := range sm.transitions {
+					got[from] = goNames(to)
+				}
+			}
+			if !reflect.DeepEqual(c.transitions, got) {
+				tt.Errorf("expected: %v, got: %v", c.transitions, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package typescript
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename = "enums.ts"
+)
+
+type generator struct {
+	filename string
+}
+
+type Option func(*generator) *generator
+
+// Filename configures the path of .ts file. It can be an absolute path or a path relative to the Go package
+// directory, e.g. ../web/src/enums.ts. enums.ts is used by default.
+func Filename(name string) Option {
+	return func(g *generator) *generator {
+		g.filename = name
+		return g
+	}
+}
+
+// NewGenerator returns a generator to write a TypeScript file which exports each enum as
+// a string literal union type, a const object and a type guard. The values are EnumKey.Name
+// which are same as the ones gqlgen marshals.
+func NewGenerator(options ...Option) enum.Generator {
+	g := &generator{
+		filename: generatedFilename,
+	}
+	for _, opts := range options {
+		g = opts(g)
+	}
+	return g
+}
+
+func (g *generator) Filename() string {
+	return g.filename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	for i, e := range enums {
+		if i > 0 {
+			fmt.Fprint(out, "\n")
+		}
+		keys := e.Keys
+		if e.Flags {
+			// bit flags are marshaled as a list of the flags so the type is the one of the list items.
+			keys = e.FlagKeys()
+		}
+		var values []string
+		for _, c := range keys {
+			values = append(values, quote(c.Name))
+		}
+		fmt.Fprintf(out, "export type %s = %s;\n", e.Name, strings.Join(values, " | "))
+		fmt.Fprintf(out, "\n")
+		fmt.Fprintf(out, "export const %s = {\n", e.Name)
+		for _, c := range keys {
+			fmt.Fprintf(out, "  %s: %s,\n", propertyName(c.Name), quote(c.Name))
+		}
+		fmt.Fprintf(out, "} as const;\n")
+		fmt.Fprintf(out, "\n")
+		fmt.Fprintf(out, "export function is%s(v: unknown): v is %s {\n", e.Name, e.Name)
+		fmt.Fprintf(out, "  return typeof v === \"string\" && (Object.values(%s) as string[]).includes(v);\n", e.Name)
+		fmt.Fprintf(out, "}\n")
+	}
+	return nil
+}
+
+var identifierRe = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+func propertyName(s string) string {
+	if identifierRe.MatchString(s) {
+		return s
+	}
+	return quote(s)
+}
+
+func quote(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
@@ -0,0 +1,93 @@
+package validator
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/yssk22/go-generators/enum"
+)
+
+const (
+	generatedFilename = "validator_enums.go"
+)
+
+type generator struct {
+}
+
+func NewGenerator() enum.Generator {
+	return &generator{}
+}
+
+func (g *generator) Filename() string {
+	return generatedFilename
+}
+
+func (g *generator) Generate(out io.Writer, enums []enum.EnumType) error {
+	fmt.Fprintf(out, "import (\n")
+	fmt.Fprintf(out, "\t\"errors\"\n")
+	fmt.Fprintf(out, "\t\"fmt\"\n")
+	fmt.Fprintf(out, ")\n")
+	fmt.Fprintf(out, "\n")
+	for _, e := range enums {
+		g.writeError(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeIsValid(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeParse(e, out)
+		fmt.Fprint(out, "\n")
+		g.writeAll(e, out)
+		fmt.Fprint(out, "\n")
+	}
+	return nil
+}
+
+func (g *generator) writeError(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "// ErrInvalid%s is returned when the value is not a valid %s.\n", e.Name, e.Name)
+	fmt.Fprintf(w, "var ErrInvalid%s = errors.New(\"invalid %s\")\n", e.Name, e.Name)
+}
+
+func (g *generator) writeIsValid(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "func (e %s) IsValid() bool {\n", e.Name)
+	if e.Flags {
+		// any combination of the flags is valid.
+		fmt.Fprintf(w, "\treturn e&^(%s) == 0\n", e.FlagMask())
+		fmt.Fprintf(w, "}\n")
+		return
+	}
+	fmt.Fprintf(w, "\tswitch e {\n")
+	for _, c := range e.DistinctKeys() {
+		fmt.Fprintf(w, "\tcase %s:\n", c.GoName)
+		fmt.Fprintf(w, "\t\treturn true\n")
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\treturn false\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeParse(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "func Parse%s(s string) (%s, error) {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\tswitch s {\n")
+	seen := make(map[string]bool)
+	for _, c := range e.Keys {
+		if seen[c.Name] {
+			continue
+		}
+		seen[c.Name] = true
+		fmt.Fprintf(w, "\tcase %q:\n", c.Name)
+		fmt.Fprintf(w, "\t\treturn %s, nil\n", c.GoName)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "\tvar zero %s\n", e.Name)
+	fmt.Fprintf(w, "\treturn zero, fmt.Errorf(\"%%w: %%q\", ErrInvalid%s, s)\n", e.Name)
+	fmt.Fprintf(w, "}\n")
+}
+
+func (g *generator) writeAll(e enum.EnumType, w io.Writer) {
+	fmt.Fprintf(w, "func All%s() []%s {\n", e.Name, e.Name)
+	fmt.Fprintf(w, "\treturn []%s{\n", e.Name)
+	for _, c := range e.DistinctKeys() {
+		fmt.Fprintf(w, "\t\t%s,\n", c.GoName)
+	}
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "}\n")
+}
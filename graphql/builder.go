@@ -3,6 +3,7 @@ package graphql
 import (
 	"errors"
 	"fmt"
+	"go/ast"
 	"go/build"
 	"go/types"
 	"io/ioutil"
@@ -12,6 +13,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/yssk22/go-generators/enum"
 	"golang.org/x/tools/go/packages"
 )
 
@@ -29,11 +31,13 @@ var standardPackages = []string{
 type TypeHelper interface {
 	IsContext(t types.Type) bool
 	IsError(t types.Type) bool
+	GetEnum(t *types.Named) (*enum.EnumType, error)
 }
 
 type builder struct {
 	standardPackageMap map[string]*packages.Package
 	targetPackage      *packages.Package
+	packageMap         map[string]*packages.Package // all loaded packages keyed by the import path
 
 	contextType *types.Interface
 
@@ -50,6 +54,17 @@ func (b *builder) IsError(t types.Type) bool {
 	return types.Implements(t, types.Universe.Lookup("error").Type().Underlying().(*types.Interface))
 }
 
+func (b *builder) GetEnum(t *types.Named) (*enum.EnumType, error) {
+	if !isEnumCandidate(t) {
+		return &enum.EnumType{Name: t.Obj().Name()}, nil
+	}
+	var files []*ast.File
+	if p, ok := b.packageMap[t.Obj().Pkg().Path()]; ok {
+		files = p.Syntax
+	}
+	return enum.GetEnum(t, files)
+}
+
 // Build analyzes the src package and returns a list of GraphQLObject
 func Build(src string, options ...Option) ([]GraphQLObject, error) {
 	builder, err := newBuilder(src)
@@ -96,6 +111,7 @@ func Build(src string, options ...Option) ([]GraphQLObject, error) {
 func newBuilder(dir string) (*builder, error) {
 	b := &builder{
 		standardPackageMap: make(map[string]*packages.Package),
+		packageMap:         make(map[string]*packages.Package),
 
 		// default option values
 		rootQueryName:    "Query",
@@ -129,6 +145,9 @@ func newBuilder(dir string) (*builder, error) {
 			b.targetPackage = p
 		}
 	}
+	packages.Visit(pkgs, nil, func(p *packages.Package) {
+		b.packageMap[p.PkgPath] = p
+	})
 	// fill build-in / standard types for TypesHelper
 	b.contextType = b.standardPackageMap["context"].Types.Scope().Lookup("Context").Type().Underlying().(*types.Interface)
 	return b, nil
@@ -3,6 +3,7 @@ package graphql
 import (
 	"errors"
 	"os"
+	"reflect"
 	"testing"
 )
 
@@ -25,6 +26,11 @@ func TestBuild(t *testing.T) {
 			queryName: "QueryWithSupportedFields",
 			err:       nil,
 		},
+		{
+			dir:       "testdata/query",
+			queryName: "QueryWithErrorOnlyReturnMethod",
+			err:       nil,
+		},
 		{
 			dir:       "testdata/query",
 			queryName: "NonExistentQuery",
@@ -85,3 +91,60 @@ func TestBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestBuild_Enum(t *testing.T) {
+	list, err := Build("testdata/enum")
+	if err != nil {
+		t.Fatalf("cannot build: %v", err)
+	}
+	objects := make(map[string]GraphQLObject)
+	for _, obj := range list {
+		objects[obj.Name] = obj
+	}
+	cases := []struct {
+		name       string
+		objectType GraphQLObjectType
+		values     []string
+	}{
+		{
+			name:       "MyEnum",
+			objectType: GraphQLObjectTypeEnum,
+			values:     []string{"ValueA", "BETA"},
+		},
+		{
+			name:       "Timeout",
+			objectType: GraphQLObjectTypeScalar,
+		},
+		{
+			name:       "Color",
+			objectType: GraphQLObjectTypeEnum,
+			values:     []string{"DARK_RED", "BLUE"},
+		},
+		{
+			name:       "Perm",
+			objectType: GraphQLObjectTypeEnum,
+			values:     []string{"Read", "Write"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(tt *testing.T) {
+			obj, ok := objects[c.name]
+			if !ok {
+				tt.Fatalf("%s is not found in %v", c.name, list)
+			}
+			if obj.ObjectType != c.objectType {
+				tt.Errorf("expected: %s, got: %s", c.objectType, obj.ObjectType)
+			}
+			if !reflect.DeepEqual(obj.Values, c.values) {
+				tt.Errorf("expected: %v, got: %v", c.values, obj.Values)
+			}
+		})
+	}
+	fields := make(map[string]GraphQLObjectField)
+	for _, f := range objects["EnumFieldsStruct"].Fields {
+		fields[f.Name] = f
+	}
+	if f := fields["fieldPerm"]; !f.IsArray || f.NestDepth != 1 || f.ElementNullable || f.Nullable {
+		t.Errorf("fieldPerm should be [Perm!]!, got: %+v", f)
+	}
+}
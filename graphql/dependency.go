@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"go/types"
 
-	"github.com/yssk22/go-generators/enum"
 	hh "github.com/yssk22/go-generators/helper"
 )
 
@@ -325,10 +324,18 @@ func (d *ScalarDependency) ToGraphQLObject(helper TypeHelper) (*GraphQLObject, [
 	if !ok {
 		return nil, nil, fmt.Errorf("unnamed scalar type: %s", t)
 	}
-	enumType := enum.GetEnum(named)
+	enumType, err := helper.GetEnum(named)
+	if err != nil {
+		return nil, nil, err
+	}
 	if len(enumType.Keys) > 0 {
+		enumKeys := enumType.Keys
+		if enumType.Flags {
+			// a field of bit flags is a list of the enum so the zero value is not an enum value.
+			enumKeys = enumType.FlagKeys()
+		}
 		var keys []string
-		for _, k := range enumType.Keys {
+		for _, k := range enumKeys {
 			keys = append(keys, k.Name)
 		}
 		return &GraphQLObject{
@@ -345,6 +352,15 @@ func (d *ScalarDependency) ToGraphQLObject(helper TypeHelper) (*GraphQLObject, [
 	}, nil, nil
 }
 
+// isEnumCandidate returns true if the named type can be an enum, which is a user defined type of a basic type.
+// Universe types such as error have no package so they are never enums.
+func isEnumCandidate(named *types.Named) bool {
+	if _, ok := named.Underlying().(*types.Basic); !ok {
+		return false
+	}
+	return named.Obj().Pkg() != nil
+}
+
 // getGraphQLObjectFromField parses the field type and compose GraphQLObjectField.
 // It also returns *Dependency if the filed depends on other type.
 func getGraphQLObjectFromField(field *types.Var, helper TypeHelper) (*GraphQLObjectField, Dependency, error) {
@@ -360,6 +376,18 @@ func getGraphQLObjectFromField(field *types.Var, helper TypeHelper) (*GraphQLObj
 	if t == BasicTypeString && field.Name() == "ID" {
 		t = BasicTypeID
 	}
+	if named, ok := fieldType.(*types.Named); ok && isEnumCandidate(named) {
+		enumType, err := helper.GetEnum(named)
+		if err != nil {
+			return nil, nil, fmt.Errorf("field error in %q: %w", field.Name(), err)
+		}
+		if enumType.Flags {
+			// bit flags cannot be held by a single enum value so they are exposed as a list of the enum.
+			isArray = true
+			elementNullable = false
+			nestDepth = nestDepth + 1
+		}
+	}
 	return &GraphQLObjectField{
 		Name:            hh.ToLowerCamleCase(field.Name()),
 		Type:            t,
@@ -0,0 +1,46 @@
+package enum
+
+import "context"
+
+type Query struct{}
+
+func (*Query) Foo(ctx context.Context) (*EnumFieldsStruct, error) {
+	return nil, nil
+}
+
+type EnumFieldsStruct struct {
+	FieldEnum    MyEnum
+	FieldTimeout Timeout
+	FieldColor   Color
+	FieldPerm    Perm
+}
+
+// enum
+type MyEnum string
+
+const (
+	MyEnumValueA MyEnum = "value_a"
+	MyEnumValueB MyEnum = "value_b" // enum:name=BETA
+)
+
+// Timeout is not marked as enum so it should be a scalar.
+type Timeout int
+
+const DefaultTimeout Timeout = 5
+
+//enum:naming=screaming_snake
+type Color string
+
+const (
+	ColorDarkRed Color = "dark_red"
+	ColorBlue    Color = "blue"
+)
+
+//enum:flags
+type Perm int
+
+const (
+	PermNone Perm = 0
+	PermRead Perm = 1 << iota
+	PermWrite
+)
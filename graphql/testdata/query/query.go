@@ -51,6 +51,12 @@ func (*QueryWithUnsupportedTypePointerOfPointer) Foo(ctx context.Context, c **st
 	return "", nil
 }
 
+type QueryWithErrorOnlyReturnMethod struct{}
+
+func (*QueryWithErrorOnlyReturnMethod) Foo(ctx context.Context) error {
+	return nil
+}
+
 type QueryWithSupportedFields struct{}
 
 func (*QueryWithSupportedFields) Foo(ctx context.Context) (*SupportedFieldsStruct, error) {
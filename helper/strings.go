@@ -34,6 +34,11 @@ func ToSnakeCase(s string) string {
 	return strings.Join(tokens, "_")
 }
 
+// ToScreamingSnakeCase converts the string to the one by upper snake case like SCREAMING_SNAKE_CASE.
+func ToScreamingSnakeCase(s string) string {
+	return strings.ToUpper(ToSnakeCase(s))
+}
+
 type runeType int
 
 const (
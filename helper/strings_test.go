@@ -74,6 +74,34 @@ func TestStrings_ToSnakeCase(t *testing.T) {
 	}
 }
 
+func TestStrings_ToScreamingSnakeCase(t *testing.T) {
+	cases := []struct {
+		input  string
+		output string
+	}{
+		{
+			input:  "ValueA",
+			output: "VALUE_A",
+		},
+		{
+			input:  "MyURL",
+			output: "MY_URL",
+		},
+		{
+			input:  "URL123IsNotGood",
+			output: "URL123_IS_NOT_GOOD",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.input, func(tt *testing.T) {
+			got := ToScreamingSnakeCase(c.input)
+			if got != c.output {
+				tt.Errorf("expected: %s, got: %s", c.output, got)
+			}
+		})
+	}
+}
+
 func TestStrings_tokenize(t *testing.T) {
 	cases := []struct {
 		input  string
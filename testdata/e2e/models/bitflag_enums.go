@@ -0,0 +1,61 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Has returns true if all bits of f are set.
+func (e Permission) Has(f Permission) bool {
+	return e&f == f
+}
+
+// Set returns the flags with the bits of f set.
+func (e Permission) Set(f Permission) Permission {
+	return e | f
+}
+
+// Clear returns the flags with the bits of f cleared.
+func (e Permission) Clear(f Permission) Permission {
+	return e &^ f
+}
+
+// Toggle returns the flags with the bits of f toggled.
+func (e Permission) Toggle(f Permission) Permission {
+	return e ^ f
+}
+
+// Flags returns the declared flags set in e.
+func (e Permission) Flags() []Permission {
+	var flags []Permission
+	for _, f := range []Permission{
+		PermissionRead,
+		PermissionWrite,
+	} {
+		if e&f == f {
+			flags = append(flags, f)
+		}
+	}
+	return flags
+}
+
+func (e Permission) String() string {
+	if e == 0 {
+		return "None"
+	}
+	var names []string
+	rest := e
+	if e&PermissionRead == PermissionRead {
+		names = append(names, "Read")
+		rest &^= PermissionRead
+	}
+	if e&PermissionWrite == PermissionWrite {
+		names = append(names, "Write")
+		rest &^= PermissionWrite
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("Permission(%d)", rest))
+	}
+	return strings.Join(names, "|")
+}
+
@@ -9,3 +9,46 @@ func (MyEnum) Values() (types []string) {
 	}
 	return
 }
+func (Priority) Values() (types []string) {
+	return []string{
+		"Low",
+		"Medium",
+		"High",
+	}
+}
+func (Severity) Values() (types []string) {
+	return []string{
+		"Low",
+		"Medium",
+		"High",
+	}
+}
+func (Permission) Values() (types []string) {
+	return []string{
+		"None",
+		"Read",
+		"Write",
+		"All",
+	}
+}
+func (Channel) Values() (types []string) {
+	for _, r := range []Channel{
+		ChannelWeb,
+		ChannelMobile,
+		ChannelUnknown,
+	} {
+		types = append(types, string(r))
+	}
+	return
+}
+func (OrderStatus) Values() (types []string) {
+	for _, r := range []OrderStatus{
+		OrderStatusPending,
+		OrderStatusShipped,
+		OrderStatusDelivered,
+		OrderStatusCancelled,
+	} {
+		types = append(types, string(r))
+	}
+	return
+}
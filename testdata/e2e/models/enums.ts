@@ -0,0 +1,70 @@
+export type MyEnum = "ValueA" | "ValueB";
+
+export const MyEnum = {
+  ValueA: "ValueA",
+  ValueB: "ValueB",
+} as const;
+
+export function isMyEnum(v: unknown): v is MyEnum {
+  return typeof v === "string" && (Object.values(MyEnum) as string[]).includes(v);
+}
+
+export type Priority = "Low" | "Medium" | "High";
+
+export const Priority = {
+  Low: "Low",
+  Medium: "Medium",
+  High: "High",
+} as const;
+
+export function isPriority(v: unknown): v is Priority {
+  return typeof v === "string" && (Object.values(Priority) as string[]).includes(v);
+}
+
+export type Severity = "Low" | "Medium" | "High";
+
+export const Severity = {
+  Low: "Low",
+  Medium: "Medium",
+  High: "High",
+} as const;
+
+export function isSeverity(v: unknown): v is Severity {
+  return typeof v === "string" && (Object.values(Severity) as string[]).includes(v);
+}
+
+export type Permission = "Read" | "Write";
+
+export const Permission = {
+  Read: "Read",
+  Write: "Write",
+} as const;
+
+export function isPermission(v: unknown): v is Permission {
+  return typeof v === "string" && (Object.values(Permission) as string[]).includes(v);
+}
+
+export type Channel = "Web" | "Mobile" | "Unknown";
+
+export const Channel = {
+  Web: "Web",
+  Mobile: "Mobile",
+  Unknown: "Unknown",
+} as const;
+
+export function isChannel(v: unknown): v is Channel {
+  return typeof v === "string" && (Object.values(Channel) as string[]).includes(v);
+}
+
+export type OrderStatus = "Pending" | "Shipped" | "Delivered" | "Cancelled";
+
+export const OrderStatus = {
+  Pending: "Pending",
+  Shipped: "Shipped",
+  Delivered: "Delivered",
+  Cancelled: "Cancelled",
+} as const;
+
+export function isOrderStatus(v: unknown): v is OrderStatus {
+  return typeof v === "string" && (Object.values(OrderStatus) as string[]).includes(v);
+}
@@ -0,0 +1,289 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/yssk22/go-generators/enum/registry"
+)
+
+func TestOrdered(t *testing.T) {
+	if !SeverityLow.Less(SeverityHigh) {
+		t.Errorf("expected: %v < %v", SeverityLow, SeverityHigh)
+	}
+	if next, ok := SeverityLow.Next(); !ok || next != SeverityMedium {
+		t.Errorf("expected: %v, got: %v", SeverityMedium, next)
+	}
+	if _, ok := SeverityHigh.Next(); ok {
+		t.Errorf("expected: no next of %v", SeverityHigh)
+	}
+	if MinSeverity() != SeverityLow || MaxSeverity() != SeverityHigh {
+		t.Errorf("expected: %v-%v, got: %v-%v", SeverityLow, SeverityHigh, MinSeverity(), MaxSeverity())
+	}
+	if !SeverityMedium.Between(SeverityLow, SeverityHigh) {
+		t.Errorf("expected: %v is between %v and %v", SeverityMedium, SeverityLow, SeverityHigh)
+	}
+}
+
+func TestBitflag(t *testing.T) {
+	p := PermissionNone.Set(PermissionRead).Set(PermissionWrite)
+	if !p.Has(PermissionRead | PermissionWrite) {
+		t.Errorf("expected: %v has all flags", p)
+	}
+	if p.String() != "Read|Write" {
+		t.Errorf("expected: %v, got: %v", "Read|Write", p.String())
+	}
+	if p != PermissionAll {
+		t.Errorf("expected: %v, got: %v", PermissionAll, p)
+	}
+	if !reflect.DeepEqual([]Permission{PermissionRead, PermissionWrite}, PermissionAll.Flags()) {
+		t.Errorf("expected: %v, got: %v", []Permission{PermissionRead, PermissionWrite}, PermissionAll.Flags())
+	}
+	if p.Clear(PermissionWrite) != PermissionRead {
+		t.Errorf("expected: %v, got: %v", PermissionRead, p.Clear(PermissionWrite))
+	}
+	if PermissionNone.String() != "None" {
+		t.Errorf("expected: %v, got: %v", "None", PermissionNone.String())
+	}
+	var buff bytes.Buffer
+	p.MarshalGQL(&buff)
+	if buff.String() != `["Read","Write"]` {
+		t.Errorf("expected: %v, got: %v", `["Read","Write"]`, buff.String())
+	}
+	var got Permission
+	if err := got.UnmarshalGQL([]interface{}{"Read", "Write"}); err != nil || got != p {
+		t.Errorf("expected: %v, got: %v (%v)", p, got, err)
+	}
+	if !p.IsValid() || Permission(4).IsValid() {
+		t.Errorf("expected: only combinations of the flags are valid")
+	}
+	b, err := json.Marshal(p)
+	if err != nil || string(b) != `["Read","Write"]` {
+		t.Errorf("expected: %v, got: %s (%v)", `["Read","Write"]`, b, err)
+	}
+	got = PermissionNone
+	if err := json.Unmarshal(b, &got); err != nil || got != p {
+		t.Errorf("expected: %v, got: %v (%v)", p, got, err)
+	}
+	v, err := p.Value()
+	if err != nil || v != int64(3) {
+		t.Errorf("expected: %v, got: %v (%v)", 3, v, err)
+	}
+	got = PermissionNone
+	if err := got.Scan(int64(3)); err != nil || got != p {
+		t.Errorf("expected: %v, got: %v (%v)", p, got, err)
+	}
+	if err := got.Scan(int64(4)); err == nil {
+		t.Errorf("expected: an error for an undeclared flag")
+	}
+}
+
+func TestText(t *testing.T) {
+	text, err := PriorityHigh.MarshalText()
+	if err != nil || string(text) != "High" {
+		t.Errorf("expected: %v, got: %s (%v)", "High", text, err)
+	}
+	var p Priority
+	if err := p.UnmarshalText(text); err != nil || p != PriorityHigh {
+		t.Errorf("expected: %v, got: %v (%v)", PriorityHigh, p, err)
+	}
+	if err := p.Set("Unknown"); err == nil {
+		t.Errorf("expected: an error, got: %v", p)
+	}
+	// map keys are encoded by MarshalText
+	b, err := json.Marshal(map[Priority]int{PriorityLow: 1})
+	if err != nil || string(b) != `{"Low":1}` {
+		t.Errorf("expected: %v, got: %s (%v)", `{"Low":1}`, b, err)
+	}
+}
+
+func TestUnknown(t *testing.T) {
+	UnknownEnumValues = nil
+	var c Channel
+	if err := json.Unmarshal([]byte(`"Tv"`), &c); err != nil || c != ChannelUnknown {
+		t.Errorf("expected: %v, got: %v (%v)", ChannelUnknown, c, err)
+	}
+	c = ChannelWeb
+	if err := c.Scan("tv"); err != nil || c != ChannelUnknown {
+		t.Errorf("expected: %v, got: %v (%v)", ChannelUnknown, c, err)
+	}
+	c = ChannelWeb
+	if err := c.UnmarshalGQL("TV"); err != nil || c != ChannelUnknown {
+		t.Errorf("expected: %v, got: %v (%v)", ChannelUnknown, c, err)
+	}
+	expected := []string{"Channel:Tv", "Channel:tv", "Channel:TV"}
+	if !reflect.DeepEqual(expected, UnknownEnumValues) {
+		t.Errorf("expected: %v, got: %v", expected, UnknownEnumValues)
+	}
+	// known values are kept
+	if err := json.Unmarshal([]byte(`"Mobile"`), &c); err != nil || c != ChannelMobile {
+		t.Errorf("expected: %v, got: %v (%v)", ChannelMobile, c, err)
+	}
+}
+
+func TestJSONNull(t *testing.T) {
+	UnknownEnumValues = nil
+	var v struct {
+		Priority   Priority
+		Channel    *Channel
+		Permission Permission
+	}
+	v.Priority = PriorityHigh
+	if err := json.Unmarshal([]byte(`{"Priority":null,"Channel":null,"Permission":null}`), &v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Priority != PriorityHigh || v.Channel != nil || v.Permission != PermissionNone {
+		t.Errorf("expected: null leaves the values unchanged, got: %+v", v)
+	}
+	if len(UnknownEnumValues) > 0 {
+		t.Errorf("expected: null is not reported as unknown, got: %v", UnknownEnumValues)
+	}
+}
+
+func TestLabel(t *testing.T) {
+	if ChannelWeb.Label() != "Web browser" || ChannelUnknown.Label() != "Unknown" {
+		t.Errorf("expected: %v, %v, got: %v, %v", "Web browser", "Unknown", ChannelWeb.Label(), ChannelUnknown.Label())
+	}
+	if ChannelWeb.Description() != "ChannelWeb is a request from browsers." {
+		t.Errorf("expected: %v, got: %v", "ChannelWeb is a request from browsers.", ChannelWeb.Description())
+	}
+	expected := map[string]string{"label": "Web browser", "icon": "globe"}
+	if !reflect.DeepEqual(expected, ChannelWeb.Meta()) {
+		t.Errorf("expected: %v, got: %v", expected, ChannelWeb.Meta())
+	}
+	if ChannelUnknown.Meta() != nil {
+		t.Errorf("expected: nil, got: %v", ChannelUnknown.Meta())
+	}
+}
+
+func TestTypeScript(t *testing.T) {
+	b, err := os.ReadFile("enums.ts")
+	if err != nil {
+		t.Fatalf("cannot read enums.ts: %v", err)
+	}
+	var channels []string
+	for _, c := range AllChannel() {
+		v, _ := json.Marshal(c)
+		channels = append(channels, string(v))
+	}
+	var permissions []string
+	for _, p := range PermissionAll.Flags() {
+		permissions = append(permissions, strconv.Quote(p.String()))
+	}
+	for _, expected := range []string{
+		"export type Channel = " + strings.Join(channels, " | ") + ";",
+		"export type Permission = " + strings.Join(permissions, " | ") + ";",
+	} {
+		if !strings.Contains(string(b), expected) {
+			t.Errorf("expected: %v in enums.ts", expected)
+		}
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	type schema struct {
+		Type  string            `json:"type"`
+		Enum  []json.RawMessage `json:"enum"`
+		Items *schema           `json:"items"`
+	}
+	var doc struct {
+		Defs map[string]*schema `json:"$defs"`
+	}
+	b, err := os.ReadFile("enums.schema.json")
+	if err != nil {
+		t.Fatalf("cannot read enums.schema.json: %v", err)
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatalf("cannot decode enums.schema.json: %v", err)
+	}
+	// the schema accepts the values encoded by MarshalJSON
+	var channels []string
+	for _, c := range AllChannel() {
+		v, _ := json.Marshal(c)
+		channels = append(channels, string(v))
+	}
+	var got []string
+	for _, v := range doc.Defs["Channel"].Enum {
+		got = append(got, string(v))
+	}
+	if !reflect.DeepEqual(channels, got) {
+		t.Errorf("expected: %v, got: %v", channels, got)
+	}
+	permission := doc.Defs["Permission"]
+	if permission.Type != "array" || permission.Items == nil {
+		t.Fatalf("expected: an array schema of Permission, got: %+v", permission)
+	}
+	var flags []string
+	b, _ = json.Marshal(PermissionAll)
+	if err := json.Unmarshal(b, &flags); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got = nil
+	for _, v := range permission.Items.Enum {
+		var s string
+		json.Unmarshal(v, &s)
+		got = append(got, s)
+	}
+	if !reflect.DeepEqual(flags, got) {
+		t.Errorf("expected: %v, got: %v", flags, got)
+	}
+}
+
+func TestDocs(t *testing.T) {
+	b, err := os.ReadFile("enums.md")
+	if err != nil {
+		t.Fatalf("cannot read enums.md: %v", err)
+	}
+	if !strings.Contains(string(b), "### Channel\n") {
+		t.Errorf("expected: a section of Channel in enums.md")
+	}
+	// each key is listed with its name and doc comment
+	for _, c := range AllChannel() {
+		expected := "| `" + c.String() + "` | `" + strconv.Quote(string(c)) + "` | " + c.Description() + " |"
+		if !strings.Contains(string(b), expected) {
+			t.Errorf("expected: %v in enums.md", expected)
+		}
+	}
+}
+
+func TestTransition(t *testing.T) {
+	expected := []OrderStatus{OrderStatusShipped, OrderStatusCancelled}
+	if !reflect.DeepEqual(expected, OrderStatusPending.AllowedTransitions()) {
+		t.Errorf("expected: %v, got: %v", expected, OrderStatusPending.AllowedTransitions())
+	}
+	next, err := OrderStatusShipped.TransitionTo(OrderStatusDelivered)
+	if err != nil || next != OrderStatusDelivered {
+		t.Errorf("expected: %v, got: %v (%v)", OrderStatusDelivered, next, err)
+	}
+	next, err = OrderStatusDelivered.TransitionTo(OrderStatusPending)
+	if !errors.Is(err, ErrInvalidOrderStatusTransition) || next != OrderStatusDelivered {
+		t.Errorf("expected: %v, got: %v (%v)", ErrInvalidOrderStatusTransition, next, err)
+	}
+	b, err := os.ReadFile("transitions.dot")
+	if err != nil {
+		t.Fatalf("cannot read transitions.dot: %v", err)
+	}
+	if !strings.Contains(string(b), `"OrderStatusPending" -> "OrderStatusShipped";`) {
+		t.Errorf("expected: the transition from Pending to Shipped in transitions.dot, got: %s", b)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	e, ok := registry.Lookup("github.com/yssk22/go-generators/testdata/e2e/models", "Channel")
+	if !ok {
+		t.Fatalf("expected: Channel is registered")
+	}
+	if !reflect.DeepEqual([]string{"Web", "Mobile", "Unknown"}, e.Names()) {
+		t.Errorf("expected: %v, got: %v", []string{"Web", "Mobile", "Unknown"}, e.Names())
+	}
+	expected := []interface{}{ChannelWeb, ChannelMobile, ChannelUnknown}
+	if !reflect.DeepEqual(expected, e.Values()) {
+		t.Errorf("expected: %v, got: %v", expected, e.Values())
+	}
+}
@@ -60,6 +60,7 @@ func (q *Query) QueryExample(ctx context.Context) (*TypeExample, error) {
 		FieldMap:                       mapValue,
 		FieldUserDefinedScalar:         userDefinedValue,
 		FieldUserDefinedEnum:           MyEnumValueA,
+		FieldUserDefinedIntEnum:        PriorityHigh,
 		FieldNullableUserDefinedScalar: &userDefinedValue,
 		FieldStruct:                    structValue,
 		FieldNullableComplex:           &structValue,
@@ -92,6 +93,7 @@ type TypeExample struct {
 	FieldUserDefinedScalar         YesNo
 	FieldNullableUserDefinedScalar *YesNo
 	FieldUserDefinedEnum           MyEnum
+	FieldUserDefinedIntEnum        Priority
 	FieldStruct                    ComplexField
 	FieldNullableComplex           *ComplexField
 	FieldInterface                 ComplexInterface
@@ -216,6 +218,7 @@ func (y YesNo) MarshalGQL(w io.Writer) {
 // 	 ValueB
 //  }
 // */
+//enum
 type MyEnum string
 
 const (
@@ -223,6 +226,22 @@ const (
 	MyEnumValueB MyEnum = "value_b"
 )
 
+// /*
+//  enum Priority {
+// 	 Low
+// 	 Medium
+// 	 High
+//  }
+// */
+//enum
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityMedium
+	PriorityHigh
+)
+
 // type MutationExample { ... }
 type MutationExample struct {
 }
@@ -246,3 +265,49 @@ func (s *MutationExample) MethodWithContextAlias(ctx MyContext, complexQueryPara
 func (s *MutationExample) privateMethod(ctx context.Context, complexQueryParams *ComplexParams) (*ComplexResult, error) {
 	return nil, nil
 }
+
+//enum:ordered
+type Severity int
+
+const (
+	SeverityLow Severity = iota
+	SeverityMedium
+	SeverityHigh
+)
+
+//enum:flags
+type Permission int
+
+const (
+	PermissionNone Permission = 0
+	PermissionRead Permission = 1 << (iota - 1)
+	PermissionWrite
+	PermissionAll = PermissionRead | PermissionWrite
+)
+
+//enum
+type Channel string
+
+const (
+	// ChannelWeb is a request from browsers.
+	ChannelWeb     Channel = "web"     // label: "Web browser" icon=globe
+	ChannelMobile  Channel = "mobile"  // label: "Mobile app"
+	ChannelUnknown Channel = "unknown" // enum:unknown
+)
+
+//enum
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "pending" // enum:next=Shipped,Cancelled
+	OrderStatusShipped   OrderStatus = "shipped" // enum:next=Delivered
+	OrderStatusDelivered OrderStatus = "delivered"
+	OrderStatusCancelled OrderStatus = "cancelled"
+)
+
+// UnknownEnumValues records the unknown values mapped to the fallback keys.
+var UnknownEnumValues []string
+
+func reportUnknownEnum(typeName string, raw interface{}) {
+	UnknownEnumValues = append(UnknownEnumValues, fmt.Sprintf("%s:%v", typeName, raw))
+}
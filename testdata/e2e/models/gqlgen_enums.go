@@ -4,28 +4,213 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 )
 
 func (e MyEnum) MarshalGQL(w io.Writer) {
 	switch e {
 	case MyEnumValueA:
-		fmt.Fprintf(w, strconv.Quote("ValueA"))
-		break
+		fmt.Fprint(w, strconv.Quote("ValueA"))
+		return
 	case MyEnumValueB:
-		fmt.Fprintf(w, strconv.Quote("ValueB"))
-		break
+		fmt.Fprint(w, strconv.Quote("ValueB"))
+		return
 	}
+	fmt.Fprint(w, "null")
 }
 
 func (e *MyEnum) UnmarshalGQL(v interface{}) error {
-	switch v.(string) {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("MyEnum must be a string, got %T", v)
+	}
+	switch s {
 	case "ValueA":
 		*e = MyEnumValueA
-		break
+		return nil
 	case "ValueB":
 		*e = MyEnumValueB
-		break
+		return nil
+	}
+	return fmt.Errorf("%q is not a valid MyEnum", s)
+}
+
+func (e Priority) MarshalGQL(w io.Writer) {
+	switch e {
+	case PriorityLow:
+		fmt.Fprint(w, strconv.Quote("Low"))
+		return
+	case PriorityMedium:
+		fmt.Fprint(w, strconv.Quote("Medium"))
+		return
+	case PriorityHigh:
+		fmt.Fprint(w, strconv.Quote("High"))
+		return
+	}
+	fmt.Fprint(w, "null")
+}
+
+func (e *Priority) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("Priority must be a string, got %T", v)
+	}
+	switch s {
+	case "Low":
+		*e = PriorityLow
+		return nil
+	case "Medium":
+		*e = PriorityMedium
+		return nil
+	case "High":
+		*e = PriorityHigh
+		return nil
+	}
+	return fmt.Errorf("%q is not a valid Priority", s)
+}
+
+func (e Severity) MarshalGQL(w io.Writer) {
+	switch e {
+	case SeverityLow:
+		fmt.Fprint(w, strconv.Quote("Low"))
+		return
+	case SeverityMedium:
+		fmt.Fprint(w, strconv.Quote("Medium"))
+		return
+	case SeverityHigh:
+		fmt.Fprint(w, strconv.Quote("High"))
+		return
+	}
+	fmt.Fprint(w, "null")
+}
+
+func (e *Severity) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("Severity must be a string, got %T", v)
+	}
+	switch s {
+	case "Low":
+		*e = SeverityLow
+		return nil
+	case "Medium":
+		*e = SeverityMedium
+		return nil
+	case "High":
+		*e = SeverityHigh
+		return nil
+	}
+	return fmt.Errorf("%q is not a valid Severity", s)
+}
+
+func (e Permission) MarshalGQL(w io.Writer) {
+	var names []string
+	if e&PermissionRead == PermissionRead {
+		names = append(names, strconv.Quote("Read"))
+	}
+	if e&PermissionWrite == PermissionWrite {
+		names = append(names, strconv.Quote("Write"))
 	}
+	fmt.Fprint(w, "["+strings.Join(names, ",")+"]")
+}
+
+func (e *Permission) UnmarshalGQL(v interface{}) error {
+	list, ok := v.([]interface{})
+	if !ok {
+		return fmt.Errorf("Permission must be a list, got %T", v)
+	}
+	var flags Permission
+	for _, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			return fmt.Errorf("Permission must be a list of strings, got %T in the list", item)
+		}
+		switch s {
+		case "Read":
+			flags |= PermissionRead
+		case "Write":
+			flags |= PermissionWrite
+		default:
+			return fmt.Errorf("%q is not a valid Permission", s)
+		}
+	}
+	*e = flags
 	return nil
 }
 
+func (e Channel) MarshalGQL(w io.Writer) {
+	switch e {
+	case ChannelWeb:
+		fmt.Fprint(w, strconv.Quote("Web"))
+		return
+	case ChannelMobile:
+		fmt.Fprint(w, strconv.Quote("Mobile"))
+		return
+	case ChannelUnknown:
+		fmt.Fprint(w, strconv.Quote("Unknown"))
+		return
+	}
+	fmt.Fprint(w, "null")
+}
+
+func (e *Channel) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("Channel must be a string, got %T", v)
+	}
+	switch s {
+	case "Web":
+		*e = ChannelWeb
+		return nil
+	case "Mobile":
+		*e = ChannelMobile
+		return nil
+	case "Unknown":
+		*e = ChannelUnknown
+		return nil
+	}
+	*e = ChannelUnknown
+	reportUnknownEnum("Channel", s)
+	return nil
+}
+
+func (e OrderStatus) MarshalGQL(w io.Writer) {
+	switch e {
+	case OrderStatusPending:
+		fmt.Fprint(w, strconv.Quote("Pending"))
+		return
+	case OrderStatusShipped:
+		fmt.Fprint(w, strconv.Quote("Shipped"))
+		return
+	case OrderStatusDelivered:
+		fmt.Fprint(w, strconv.Quote("Delivered"))
+		return
+	case OrderStatusCancelled:
+		fmt.Fprint(w, strconv.Quote("Cancelled"))
+		return
+	}
+	fmt.Fprint(w, "null")
+}
+
+func (e *OrderStatus) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("OrderStatus must be a string, got %T", v)
+	}
+	switch s {
+	case "Pending":
+		*e = OrderStatusPending
+		return nil
+	case "Shipped":
+		*e = OrderStatusShipped
+		return nil
+	case "Delivered":
+		*e = OrderStatusDelivered
+		return nil
+	case "Cancelled":
+		*e = OrderStatusCancelled
+		return nil
+	}
+	return fmt.Errorf("%q is not a valid OrderStatus", s)
+}
+
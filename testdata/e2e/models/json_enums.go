@@ -0,0 +1,226 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// EnumJSONError is returned when the enum value cannot be converted from/to JSON.
+type EnumJSONError struct {
+	Type  string
+	Value string
+}
+
+func (e *EnumJSONError) Error() string {
+	return fmt.Sprintf("invalid %s value: %s", e.Type, e.Value)
+}
+
+func (e MyEnum) MarshalJSON() ([]byte, error) {
+	switch e {
+	case MyEnumValueA:
+		return []byte("\"ValueA\""), nil
+	case MyEnumValueB:
+		return []byte("\"ValueB\""), nil
+	}
+	return nil, &EnumJSONError{Type: "MyEnum", Value: fmt.Sprintf("%#v", e)}
+}
+
+func (e *MyEnum) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch string(v) {
+	case "ValueA":
+		*e = MyEnumValueA
+		return nil
+	case "ValueB":
+		*e = MyEnumValueB
+		return nil
+	}
+	return &EnumJSONError{Type: "MyEnum", Value: string(v)}
+}
+
+func (e Priority) MarshalJSON() ([]byte, error) {
+	switch e {
+	case PriorityLow:
+		return []byte("\"Low\""), nil
+	case PriorityMedium:
+		return []byte("\"Medium\""), nil
+	case PriorityHigh:
+		return []byte("\"High\""), nil
+	}
+	return nil, &EnumJSONError{Type: "Priority", Value: fmt.Sprintf("%#v", e)}
+}
+
+func (e *Priority) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch string(v) {
+	case "Low":
+		*e = PriorityLow
+		return nil
+	case "Medium":
+		*e = PriorityMedium
+		return nil
+	case "High":
+		*e = PriorityHigh
+		return nil
+	}
+	return &EnumJSONError{Type: "Priority", Value: string(v)}
+}
+
+func (e Severity) MarshalJSON() ([]byte, error) {
+	switch e {
+	case SeverityLow:
+		return []byte("\"Low\""), nil
+	case SeverityMedium:
+		return []byte("\"Medium\""), nil
+	case SeverityHigh:
+		return []byte("\"High\""), nil
+	}
+	return nil, &EnumJSONError{Type: "Severity", Value: fmt.Sprintf("%#v", e)}
+}
+
+func (e *Severity) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch string(v) {
+	case "Low":
+		*e = SeverityLow
+		return nil
+	case "Medium":
+		*e = SeverityMedium
+		return nil
+	case "High":
+		*e = SeverityHigh
+		return nil
+	}
+	return &EnumJSONError{Type: "Severity", Value: string(v)}
+}
+
+func (e Permission) MarshalJSON() ([]byte, error) {
+	if e&^(PermissionRead | PermissionWrite) != 0 {
+		return nil, &EnumJSONError{Type: "Permission", Value: fmt.Sprintf("%#v", e)}
+	}
+	names := []string{}
+	if e&PermissionRead == PermissionRead {
+		names = append(names, "Read")
+	}
+	if e&PermissionWrite == PermissionWrite {
+		names = append(names, "Write")
+	}
+	return json.Marshal(names)
+}
+
+func (e *Permission) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var v []string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	var flags Permission
+	for _, s := range v {
+		switch s {
+		case "Read":
+			flags |= PermissionRead
+		case "Write":
+			flags |= PermissionWrite
+		default:
+			return &EnumJSONError{Type: "Permission", Value: s}
+		}
+	}
+	*e = flags
+	return nil
+}
+
+func (e Channel) MarshalJSON() ([]byte, error) {
+	switch e {
+	case ChannelWeb:
+		return []byte("\"Web\""), nil
+	case ChannelMobile:
+		return []byte("\"Mobile\""), nil
+	case ChannelUnknown:
+		return []byte("\"Unknown\""), nil
+	}
+	return nil, &EnumJSONError{Type: "Channel", Value: fmt.Sprintf("%#v", e)}
+}
+
+func (e *Channel) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch string(v) {
+	case "Web":
+		*e = ChannelWeb
+		return nil
+	case "Mobile":
+		*e = ChannelMobile
+		return nil
+	case "Unknown":
+		*e = ChannelUnknown
+		return nil
+	}
+	*e = ChannelUnknown
+	reportUnknownEnum("Channel", string(v))
+	return nil
+}
+
+func (e OrderStatus) MarshalJSON() ([]byte, error) {
+	switch e {
+	case OrderStatusPending:
+		return []byte("\"Pending\""), nil
+	case OrderStatusShipped:
+		return []byte("\"Shipped\""), nil
+	case OrderStatusDelivered:
+		return []byte("\"Delivered\""), nil
+	case OrderStatusCancelled:
+		return []byte("\"Cancelled\""), nil
+	}
+	return nil, &EnumJSONError{Type: "OrderStatus", Value: fmt.Sprintf("%#v", e)}
+}
+
+func (e *OrderStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch string(v) {
+	case "Pending":
+		*e = OrderStatusPending
+		return nil
+	case "Shipped":
+		*e = OrderStatusShipped
+		return nil
+	case "Delivered":
+		*e = OrderStatusDelivered
+		return nil
+	case "Cancelled":
+		*e = OrderStatusCancelled
+		return nil
+	}
+	return &EnumJSONError{Type: "OrderStatus", Value: string(v)}
+}
+
@@ -0,0 +1,177 @@
+package models
+
+// Label returns the human readable label of the value.
+func (e MyEnum) Label() string {
+	switch e {
+	case MyEnumValueA:
+		return "ValueA"
+	case MyEnumValueB:
+		return "ValueB"
+	}
+	return ""
+}
+
+// Description returns the doc comment of the value.
+func (e MyEnum) Description() string {
+	switch e {
+	}
+	return ""
+}
+
+// Meta returns the annotations of the value. The returned map can be modified by the caller.
+func (e MyEnum) Meta() map[string]string {
+	switch e {
+	}
+	return nil
+}
+
+// Label returns the human readable label of the value.
+func (e Priority) Label() string {
+	switch e {
+	case PriorityLow:
+		return "Low"
+	case PriorityMedium:
+		return "Medium"
+	case PriorityHigh:
+		return "High"
+	}
+	return ""
+}
+
+// Description returns the doc comment of the value.
+func (e Priority) Description() string {
+	switch e {
+	}
+	return ""
+}
+
+// Meta returns the annotations of the value. The returned map can be modified by the caller.
+func (e Priority) Meta() map[string]string {
+	switch e {
+	}
+	return nil
+}
+
+// Label returns the human readable label of the value.
+func (e Severity) Label() string {
+	switch e {
+	case SeverityLow:
+		return "Low"
+	case SeverityMedium:
+		return "Medium"
+	case SeverityHigh:
+		return "High"
+	}
+	return ""
+}
+
+// Description returns the doc comment of the value.
+func (e Severity) Description() string {
+	switch e {
+	}
+	return ""
+}
+
+// Meta returns the annotations of the value. The returned map can be modified by the caller.
+func (e Severity) Meta() map[string]string {
+	switch e {
+	}
+	return nil
+}
+
+// Label returns the human readable label of the value.
+func (e Permission) Label() string {
+	switch e {
+	case PermissionNone:
+		return "None"
+	case PermissionRead:
+		return "Read"
+	case PermissionWrite:
+		return "Write"
+	case PermissionAll:
+		return "All"
+	}
+	return ""
+}
+
+// Description returns the doc comment of the value.
+func (e Permission) Description() string {
+	switch e {
+	}
+	return ""
+}
+
+// Meta returns the annotations of the value. The returned map can be modified by the caller.
+func (e Permission) Meta() map[string]string {
+	switch e {
+	}
+	return nil
+}
+
+// Label returns the human readable label of the value.
+func (e Channel) Label() string {
+	switch e {
+	case ChannelWeb:
+		return "Web browser"
+	case ChannelMobile:
+		return "Mobile app"
+	case ChannelUnknown:
+		return "Unknown"
+	}
+	return ""
+}
+
+// Description returns the doc comment of the value.
+func (e Channel) Description() string {
+	switch e {
+	case ChannelWeb:
+		return "ChannelWeb is a request from browsers."
+	}
+	return ""
+}
+
+// Meta returns the annotations of the value. The returned map can be modified by the caller.
+func (e Channel) Meta() map[string]string {
+	switch e {
+	case ChannelWeb:
+		return map[string]string{
+			"icon": "globe",
+			"label": "Web browser",
+		}
+	case ChannelMobile:
+		return map[string]string{
+			"label": "Mobile app",
+		}
+	}
+	return nil
+}
+
+// Label returns the human readable label of the value.
+func (e OrderStatus) Label() string {
+	switch e {
+	case OrderStatusPending:
+		return "Pending"
+	case OrderStatusShipped:
+		return "Shipped"
+	case OrderStatusDelivered:
+		return "Delivered"
+	case OrderStatusCancelled:
+		return "Cancelled"
+	}
+	return ""
+}
+
+// Description returns the doc comment of the value.
+func (e OrderStatus) Description() string {
+	switch e {
+	}
+	return ""
+}
+
+// Meta returns the annotations of the value. The returned map can be modified by the caller.
+func (e OrderStatus) Meta() map[string]string {
+	switch e {
+	}
+	return nil
+}
+
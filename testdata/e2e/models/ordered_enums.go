@@ -0,0 +1,70 @@
+package models
+
+// ordinal returns the position of e in the declaration order, or -1 if e is not declared.
+func (e Severity) ordinal() int {
+	switch e {
+	case SeverityLow:
+		return 0
+	case SeverityMedium:
+		return 1
+	case SeverityHigh:
+		return 2
+	}
+	return -1
+}
+
+// Compare returns -1, 0 or +1 if e is declared before, same as or after other.
+// Undeclared values are before all declared values.
+func (e Severity) Compare(other Severity) int {
+	a, b := e.ordinal(), other.ordinal()
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// Less returns true if e is declared before other.
+func (e Severity) Less(other Severity) bool {
+	return e.ordinal() < other.ordinal()
+}
+
+// Next returns the value declared next to e, or false if e is the last or not declared.
+func (e Severity) Next() (Severity, bool) {
+	switch e {
+	case SeverityLow:
+		return SeverityMedium, true
+	case SeverityMedium:
+		return SeverityHigh, true
+	}
+	return e, false
+}
+
+// Prev returns the value declared previous to e, or false if e is the first or not declared.
+func (e Severity) Prev() (Severity, bool) {
+	switch e {
+	case SeverityMedium:
+		return SeverityLow, true
+	case SeverityHigh:
+		return SeverityMedium, true
+	}
+	return e, false
+}
+
+// MinSeverity returns the first declared value.
+func MinSeverity() Severity {
+	return SeverityLow
+}
+
+// MaxSeverity returns the last declared value.
+func MaxSeverity() Severity {
+	return SeverityHigh
+}
+
+// Between returns true if e is declared between a and b inclusive.
+func (e Severity) Between(a, b Severity) bool {
+	return a.Compare(e) <= 0 && e.Compare(b) <= 0
+}
+
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"github.com/yssk22/go-generators/enum/registry"
+)
+
+func init() {
+	registry.Register(&registry.Enum{
+		Name:    "MyEnum",
+		PkgPath: "github.com/yssk22/go-generators/testdata/e2e/models",
+		Keys: []registry.Key{
+			{GoName: "MyEnumValueA", Name: "ValueA", Value: MyEnumValueA},
+			{GoName: "MyEnumValueB", Name: "ValueB", Value: MyEnumValueB},
+		},
+	})
+	registry.Register(&registry.Enum{
+		Name:    "Priority",
+		PkgPath: "github.com/yssk22/go-generators/testdata/e2e/models",
+		Keys: []registry.Key{
+			{GoName: "PriorityLow", Name: "Low", Value: PriorityLow},
+			{GoName: "PriorityMedium", Name: "Medium", Value: PriorityMedium},
+			{GoName: "PriorityHigh", Name: "High", Value: PriorityHigh},
+		},
+	})
+	registry.Register(&registry.Enum{
+		Name:    "Severity",
+		PkgPath: "github.com/yssk22/go-generators/testdata/e2e/models",
+		Keys: []registry.Key{
+			{GoName: "SeverityLow", Name: "Low", Value: SeverityLow},
+			{GoName: "SeverityMedium", Name: "Medium", Value: SeverityMedium},
+			{GoName: "SeverityHigh", Name: "High", Value: SeverityHigh},
+		},
+	})
+	registry.Register(&registry.Enum{
+		Name:    "Permission",
+		PkgPath: "github.com/yssk22/go-generators/testdata/e2e/models",
+		Keys: []registry.Key{
+			{GoName: "PermissionNone", Name: "None", Value: PermissionNone},
+			{GoName: "PermissionRead", Name: "Read", Value: PermissionRead},
+			{GoName: "PermissionWrite", Name: "Write", Value: PermissionWrite},
+			{GoName: "PermissionAll", Name: "All", Value: PermissionAll},
+		},
+	})
+	registry.Register(&registry.Enum{
+		Name:    "Channel",
+		PkgPath: "github.com/yssk22/go-generators/testdata/e2e/models",
+		Keys: []registry.Key{
+			{GoName: "ChannelWeb", Name: "Web", Value: ChannelWeb},
+			{GoName: "ChannelMobile", Name: "Mobile", Value: ChannelMobile},
+			{GoName: "ChannelUnknown", Name: "Unknown", Value: ChannelUnknown},
+		},
+	})
+	registry.Register(&registry.Enum{
+		Name:    "OrderStatus",
+		PkgPath: "github.com/yssk22/go-generators/testdata/e2e/models",
+		Keys: []registry.Key{
+			{GoName: "OrderStatusPending", Name: "Pending", Value: OrderStatusPending},
+			{GoName: "OrderStatusShipped", Name: "Shipped", Value: OrderStatusShipped},
+			{GoName: "OrderStatusDelivered", Name: "Delivered", Value: OrderStatusDelivered},
+			{GoName: "OrderStatusCancelled", Name: "Cancelled", Value: OrderStatusCancelled},
+		},
+	})
+}
@@ -0,0 +1,194 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+func (e *MyEnum) Scan(src interface{}) error {
+	var v string
+	switch s := src.(type) {
+	case string:
+		v = s
+	case []byte:
+		v = string(s)
+	default:
+		return fmt.Errorf("cannot scan %T into MyEnum", src)
+	}
+	switch MyEnum(v) {
+	case MyEnumValueA, MyEnumValueB:
+		*e = MyEnum(v)
+		return nil
+	}
+	return fmt.Errorf("invalid MyEnum value: %v", v)
+}
+
+func (e MyEnum) Value() (driver.Value, error) {
+	switch e {
+	case MyEnumValueA, MyEnumValueB:
+		return string(e), nil
+	}
+	return nil, fmt.Errorf("invalid MyEnum value: %#v", e)
+}
+
+func (e *Priority) Scan(src interface{}) error {
+	var v int64
+	switch s := src.(type) {
+	case int64:
+		v = s
+	case []byte:
+		i, err := strconv.ParseInt(string(s), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Priority value: %q", s)
+		}
+		v = i
+	case string:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Priority value: %q", s)
+		}
+		v = i
+	default:
+		return fmt.Errorf("cannot scan %T into Priority", src)
+	}
+	switch Priority(v) {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+		*e = Priority(v)
+		return nil
+	}
+	return fmt.Errorf("invalid Priority value: %v", v)
+}
+
+func (e Priority) Value() (driver.Value, error) {
+	switch e {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+		return int64(e), nil
+	}
+	return nil, fmt.Errorf("invalid Priority value: %#v", e)
+}
+
+func (e *Severity) Scan(src interface{}) error {
+	var v int64
+	switch s := src.(type) {
+	case int64:
+		v = s
+	case []byte:
+		i, err := strconv.ParseInt(string(s), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Severity value: %q", s)
+		}
+		v = i
+	case string:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Severity value: %q", s)
+		}
+		v = i
+	default:
+		return fmt.Errorf("cannot scan %T into Severity", src)
+	}
+	switch Severity(v) {
+	case SeverityLow, SeverityMedium, SeverityHigh:
+		*e = Severity(v)
+		return nil
+	}
+	return fmt.Errorf("invalid Severity value: %v", v)
+}
+
+func (e Severity) Value() (driver.Value, error) {
+	switch e {
+	case SeverityLow, SeverityMedium, SeverityHigh:
+		return int64(e), nil
+	}
+	return nil, fmt.Errorf("invalid Severity value: %#v", e)
+}
+
+func (e *Permission) Scan(src interface{}) error {
+	var v int64
+	switch s := src.(type) {
+	case int64:
+		v = s
+	case []byte:
+		i, err := strconv.ParseInt(string(s), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Permission value: %q", s)
+		}
+		v = i
+	case string:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Permission value: %q", s)
+		}
+		v = i
+	default:
+		return fmt.Errorf("cannot scan %T into Permission", src)
+	}
+	if Permission(v)&^(PermissionRead | PermissionWrite) == 0 {
+		*e = Permission(v)
+		return nil
+	}
+	return fmt.Errorf("invalid Permission value: %v", v)
+}
+
+func (e Permission) Value() (driver.Value, error) {
+	if e&^(PermissionRead | PermissionWrite) == 0 {
+		return int64(e), nil
+	}
+	return nil, fmt.Errorf("invalid Permission value: %#v", e)
+}
+
+func (e *Channel) Scan(src interface{}) error {
+	var v string
+	switch s := src.(type) {
+	case string:
+		v = s
+	case []byte:
+		v = string(s)
+	default:
+		return fmt.Errorf("cannot scan %T into Channel", src)
+	}
+	switch Channel(v) {
+	case ChannelWeb, ChannelMobile, ChannelUnknown:
+		*e = Channel(v)
+		return nil
+	}
+	*e = ChannelUnknown
+	reportUnknownEnum("Channel", v)
+	return nil
+}
+
+func (e Channel) Value() (driver.Value, error) {
+	switch e {
+	case ChannelWeb, ChannelMobile, ChannelUnknown:
+		return string(e), nil
+	}
+	return nil, fmt.Errorf("invalid Channel value: %#v", e)
+}
+
+func (e *OrderStatus) Scan(src interface{}) error {
+	var v string
+	switch s := src.(type) {
+	case string:
+		v = s
+	case []byte:
+		v = string(s)
+	default:
+		return fmt.Errorf("cannot scan %T into OrderStatus", src)
+	}
+	switch OrderStatus(v) {
+	case OrderStatusPending, OrderStatusShipped, OrderStatusDelivered, OrderStatusCancelled:
+		*e = OrderStatus(v)
+		return nil
+	}
+	return fmt.Errorf("invalid OrderStatus value: %v", v)
+}
+
+func (e OrderStatus) Value() (driver.Value, error) {
+	switch e {
+	case OrderStatusPending, OrderStatusShipped, OrderStatusDelivered, OrderStatusCancelled:
+		return string(e), nil
+	}
+	return nil, fmt.Errorf("invalid OrderStatus value: %#v", e)
+}
+
@@ -0,0 +1,131 @@
+package models
+
+import (
+	"fmt"
+)
+
+func (e MyEnum) String() string {
+	switch e {
+	case MyEnumValueA:
+		return "ValueA"
+	case MyEnumValueB:
+		return "ValueB"
+	}
+	return fmt.Sprintf("MyEnum(%#v)", e)
+}
+
+func MyEnumFromString(s string) (MyEnum, bool) {
+	switch s {
+	case "ValueA":
+		return MyEnumValueA, true
+	case "ValueB":
+		return MyEnumValueB, true
+	}
+	var zero MyEnum
+	return zero, false
+}
+
+func (e Priority) String() string {
+	switch e {
+	case PriorityLow:
+		return "Low"
+	case PriorityMedium:
+		return "Medium"
+	case PriorityHigh:
+		return "High"
+	}
+	return fmt.Sprintf("Priority(%#v)", e)
+}
+
+func PriorityFromString(s string) (Priority, bool) {
+	switch s {
+	case "Low":
+		return PriorityLow, true
+	case "Medium":
+		return PriorityMedium, true
+	case "High":
+		return PriorityHigh, true
+	}
+	var zero Priority
+	return zero, false
+}
+
+func (e Severity) String() string {
+	switch e {
+	case SeverityLow:
+		return "Low"
+	case SeverityMedium:
+		return "Medium"
+	case SeverityHigh:
+		return "High"
+	}
+	return fmt.Sprintf("Severity(%#v)", e)
+}
+
+func SeverityFromString(s string) (Severity, bool) {
+	switch s {
+	case "Low":
+		return SeverityLow, true
+	case "Medium":
+		return SeverityMedium, true
+	case "High":
+		return SeverityHigh, true
+	}
+	var zero Severity
+	return zero, false
+}
+
+func (e Channel) String() string {
+	switch e {
+	case ChannelWeb:
+		return "Web"
+	case ChannelMobile:
+		return "Mobile"
+	case ChannelUnknown:
+		return "Unknown"
+	}
+	return fmt.Sprintf("Channel(%#v)", e)
+}
+
+func ChannelFromString(s string) (Channel, bool) {
+	switch s {
+	case "Web":
+		return ChannelWeb, true
+	case "Mobile":
+		return ChannelMobile, true
+	case "Unknown":
+		return ChannelUnknown, true
+	}
+	var zero Channel
+	return zero, false
+}
+
+func (e OrderStatus) String() string {
+	switch e {
+	case OrderStatusPending:
+		return "Pending"
+	case OrderStatusShipped:
+		return "Shipped"
+	case OrderStatusDelivered:
+		return "Delivered"
+	case OrderStatusCancelled:
+		return "Cancelled"
+	}
+	return fmt.Sprintf("OrderStatus(%#v)", e)
+}
+
+func OrderStatusFromString(s string) (OrderStatus, bool) {
+	switch s {
+	case "Pending":
+		return OrderStatusPending, true
+	case "Shipped":
+		return OrderStatusShipped, true
+	case "Delivered":
+		return OrderStatusDelivered, true
+	case "Cancelled":
+		return OrderStatusCancelled, true
+	}
+	var zero OrderStatus
+	return zero, false
+}
+
@@ -0,0 +1,201 @@
+package models
+
+import (
+	"fmt"
+)
+
+// MyEnumUsage lists the allowed values of MyEnum for flag usages and error messages.
+const MyEnumUsage = "one of ValueA, ValueB"
+
+func (e MyEnum) MarshalText() ([]byte, error) {
+	switch e {
+	case MyEnumValueA:
+		return []byte("ValueA"), nil
+	case MyEnumValueB:
+		return []byte("ValueB"), nil
+	}
+	return nil, fmt.Errorf("invalid MyEnum value: %#v", e)
+}
+
+func (e *MyEnum) UnmarshalText(text []byte) error {
+	return e.Set(string(text))
+}
+
+func (e *MyEnum) Set(s string) error {
+	switch s {
+	case "ValueA":
+		*e = MyEnumValueA
+		return nil
+	case "ValueB":
+		*e = MyEnumValueB
+		return nil
+	}
+	return fmt.Errorf("invalid MyEnum value: %q, must be %s", s, MyEnumUsage)
+}
+
+// Type returns the type name shown in pflag usages.
+func (e MyEnum) Type() string {
+	return "MyEnum"
+}
+
+// PriorityUsage lists the allowed values of Priority for flag usages and error messages.
+const PriorityUsage = "one of Low, Medium, High"
+
+func (e Priority) MarshalText() ([]byte, error) {
+	switch e {
+	case PriorityLow:
+		return []byte("Low"), nil
+	case PriorityMedium:
+		return []byte("Medium"), nil
+	case PriorityHigh:
+		return []byte("High"), nil
+	}
+	return nil, fmt.Errorf("invalid Priority value: %#v", e)
+}
+
+func (e *Priority) UnmarshalText(text []byte) error {
+	return e.Set(string(text))
+}
+
+func (e *Priority) Set(s string) error {
+	switch s {
+	case "Low":
+		*e = PriorityLow
+		return nil
+	case "Medium":
+		*e = PriorityMedium
+		return nil
+	case "High":
+		*e = PriorityHigh
+		return nil
+	}
+	return fmt.Errorf("invalid Priority value: %q, must be %s", s, PriorityUsage)
+}
+
+// Type returns the type name shown in pflag usages.
+func (e Priority) Type() string {
+	return "Priority"
+}
+
+// SeverityUsage lists the allowed values of Severity for flag usages and error messages.
+const SeverityUsage = "one of Low, Medium, High"
+
+func (e Severity) MarshalText() ([]byte, error) {
+	switch e {
+	case SeverityLow:
+		return []byte("Low"), nil
+	case SeverityMedium:
+		return []byte("Medium"), nil
+	case SeverityHigh:
+		return []byte("High"), nil
+	}
+	return nil, fmt.Errorf("invalid Severity value: %#v", e)
+}
+
+func (e *Severity) UnmarshalText(text []byte) error {
+	return e.Set(string(text))
+}
+
+func (e *Severity) Set(s string) error {
+	switch s {
+	case "Low":
+		*e = SeverityLow
+		return nil
+	case "Medium":
+		*e = SeverityMedium
+		return nil
+	case "High":
+		*e = SeverityHigh
+		return nil
+	}
+	return fmt.Errorf("invalid Severity value: %q, must be %s", s, SeverityUsage)
+}
+
+// Type returns the type name shown in pflag usages.
+func (e Severity) Type() string {
+	return "Severity"
+}
+
+// ChannelUsage lists the allowed values of Channel for flag usages and error messages.
+const ChannelUsage = "one of Web, Mobile, Unknown"
+
+func (e Channel) MarshalText() ([]byte, error) {
+	switch e {
+	case ChannelWeb:
+		return []byte("Web"), nil
+	case ChannelMobile:
+		return []byte("Mobile"), nil
+	case ChannelUnknown:
+		return []byte("Unknown"), nil
+	}
+	return nil, fmt.Errorf("invalid Channel value: %#v", e)
+}
+
+func (e *Channel) UnmarshalText(text []byte) error {
+	return e.Set(string(text))
+}
+
+func (e *Channel) Set(s string) error {
+	switch s {
+	case "Web":
+		*e = ChannelWeb
+		return nil
+	case "Mobile":
+		*e = ChannelMobile
+		return nil
+	case "Unknown":
+		*e = ChannelUnknown
+		return nil
+	}
+	return fmt.Errorf("invalid Channel value: %q, must be %s", s, ChannelUsage)
+}
+
+// Type returns the type name shown in pflag usages.
+func (e Channel) Type() string {
+	return "Channel"
+}
+
+// OrderStatusUsage lists the allowed values of OrderStatus for flag usages and error messages.
+const OrderStatusUsage = "one of Pending, Shipped, Delivered, Cancelled"
+
+func (e OrderStatus) MarshalText() ([]byte, error) {
+	switch e {
+	case OrderStatusPending:
+		return []byte("Pending"), nil
+	case OrderStatusShipped:
+		return []byte("Shipped"), nil
+	case OrderStatusDelivered:
+		return []byte("Delivered"), nil
+	case OrderStatusCancelled:
+		return []byte("Cancelled"), nil
+	}
+	return nil, fmt.Errorf("invalid OrderStatus value: %#v", e)
+}
+
+func (e *OrderStatus) UnmarshalText(text []byte) error {
+	return e.Set(string(text))
+}
+
+func (e *OrderStatus) Set(s string) error {
+	switch s {
+	case "Pending":
+		*e = OrderStatusPending
+		return nil
+	case "Shipped":
+		*e = OrderStatusShipped
+		return nil
+	case "Delivered":
+		*e = OrderStatusDelivered
+		return nil
+	case "Cancelled":
+		*e = OrderStatusCancelled
+		return nil
+	}
+	return fmt.Errorf("invalid OrderStatus value: %q, must be %s", s, OrderStatusUsage)
+}
+
+// Type returns the type name shown in pflag usages.
+func (e OrderStatus) Type() string {
+	return "OrderStatus"
+}
+
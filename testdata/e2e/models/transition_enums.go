@@ -0,0 +1,46 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidOrderStatusTransition is returned when the transition is not allowed.
+var ErrInvalidOrderStatusTransition = errors.New("invalid OrderStatus transition")
+
+// AllowedTransitions returns the values e can transition to.
+func (e OrderStatus) AllowedTransitions() []OrderStatus {
+	switch e {
+	case OrderStatusPending:
+		return []OrderStatus{OrderStatusShipped, OrderStatusCancelled}
+	case OrderStatusShipped:
+		return []OrderStatus{OrderStatusDelivered}
+	}
+	return nil
+}
+
+// CanTransitionTo returns true if e can transition to next.
+func (e OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	switch e {
+	case OrderStatusPending:
+		switch next {
+		case OrderStatusShipped, OrderStatusCancelled:
+			return true
+		}
+	case OrderStatusShipped:
+		switch next {
+		case OrderStatusDelivered:
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionTo returns next if e can transition to next, otherwise returns e with an error wrapping ErrInvalidOrderStatusTransition.
+func (e OrderStatus) TransitionTo(next OrderStatus) (OrderStatus, error) {
+	if !e.CanTransitionTo(next) {
+		return e, fmt.Errorf("%w: from %v to %v", ErrInvalidOrderStatusTransition, e, next)
+	}
+	return next, nil
+}
+
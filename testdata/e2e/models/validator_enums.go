@@ -0,0 +1,218 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidMyEnum is returned when the value is not a valid MyEnum.
+var ErrInvalidMyEnum = errors.New("invalid MyEnum")
+
+func (e MyEnum) IsValid() bool {
+	switch e {
+	case MyEnumValueA:
+		return true
+	case MyEnumValueB:
+		return true
+	}
+	return false
+}
+
+func ParseMyEnum(s string) (MyEnum, error) {
+	switch s {
+	case "ValueA":
+		return MyEnumValueA, nil
+	case "ValueB":
+		return MyEnumValueB, nil
+	}
+	var zero MyEnum
+	return zero, fmt.Errorf("%w: %q", ErrInvalidMyEnum, s)
+}
+
+func AllMyEnum() []MyEnum {
+	return []MyEnum{
+		MyEnumValueA,
+		MyEnumValueB,
+	}
+}
+
+// ErrInvalidPriority is returned when the value is not a valid Priority.
+var ErrInvalidPriority = errors.New("invalid Priority")
+
+func (e Priority) IsValid() bool {
+	switch e {
+	case PriorityLow:
+		return true
+	case PriorityMedium:
+		return true
+	case PriorityHigh:
+		return true
+	}
+	return false
+}
+
+func ParsePriority(s string) (Priority, error) {
+	switch s {
+	case "Low":
+		return PriorityLow, nil
+	case "Medium":
+		return PriorityMedium, nil
+	case "High":
+		return PriorityHigh, nil
+	}
+	var zero Priority
+	return zero, fmt.Errorf("%w: %q", ErrInvalidPriority, s)
+}
+
+func AllPriority() []Priority {
+	return []Priority{
+		PriorityLow,
+		PriorityMedium,
+		PriorityHigh,
+	}
+}
+
+// ErrInvalidSeverity is returned when the value is not a valid Severity.
+var ErrInvalidSeverity = errors.New("invalid Severity")
+
+func (e Severity) IsValid() bool {
+	switch e {
+	case SeverityLow:
+		return true
+	case SeverityMedium:
+		return true
+	case SeverityHigh:
+		return true
+	}
+	return false
+}
+
+func ParseSeverity(s string) (Severity, error) {
+	switch s {
+	case "Low":
+		return SeverityLow, nil
+	case "Medium":
+		return SeverityMedium, nil
+	case "High":
+		return SeverityHigh, nil
+	}
+	var zero Severity
+	return zero, fmt.Errorf("%w: %q", ErrInvalidSeverity, s)
+}
+
+func AllSeverity() []Severity {
+	return []Severity{
+		SeverityLow,
+		SeverityMedium,
+		SeverityHigh,
+	}
+}
+
+// ErrInvalidPermission is returned when the value is not a valid Permission.
+var ErrInvalidPermission = errors.New("invalid Permission")
+
+func (e Permission) IsValid() bool {
+	return e&^(PermissionRead | PermissionWrite) == 0
+}
+
+func ParsePermission(s string) (Permission, error) {
+	switch s {
+	case "None":
+		return PermissionNone, nil
+	case "Read":
+		return PermissionRead, nil
+	case "Write":
+		return PermissionWrite, nil
+	case "All":
+		return PermissionAll, nil
+	}
+	var zero Permission
+	return zero, fmt.Errorf("%w: %q", ErrInvalidPermission, s)
+}
+
+func AllPermission() []Permission {
+	return []Permission{
+		PermissionNone,
+		PermissionRead,
+		PermissionWrite,
+		PermissionAll,
+	}
+}
+
+// ErrInvalidChannel is returned when the value is not a valid Channel.
+var ErrInvalidChannel = errors.New("invalid Channel")
+
+func (e Channel) IsValid() bool {
+	switch e {
+	case ChannelWeb:
+		return true
+	case ChannelMobile:
+		return true
+	case ChannelUnknown:
+		return true
+	}
+	return false
+}
+
+func ParseChannel(s string) (Channel, error) {
+	switch s {
+	case "Web":
+		return ChannelWeb, nil
+	case "Mobile":
+		return ChannelMobile, nil
+	case "Unknown":
+		return ChannelUnknown, nil
+	}
+	var zero Channel
+	return zero, fmt.Errorf("%w: %q", ErrInvalidChannel, s)
+}
+
+func AllChannel() []Channel {
+	return []Channel{
+		ChannelWeb,
+		ChannelMobile,
+		ChannelUnknown,
+	}
+}
+
+// ErrInvalidOrderStatus is returned when the value is not a valid OrderStatus.
+var ErrInvalidOrderStatus = errors.New("invalid OrderStatus")
+
+func (e OrderStatus) IsValid() bool {
+	switch e {
+	case OrderStatusPending:
+		return true
+	case OrderStatusShipped:
+		return true
+	case OrderStatusDelivered:
+		return true
+	case OrderStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func ParseOrderStatus(s string) (OrderStatus, error) {
+	switch s {
+	case "Pending":
+		return OrderStatusPending, nil
+	case "Shipped":
+		return OrderStatusShipped, nil
+	case "Delivered":
+		return OrderStatusDelivered, nil
+	case "Cancelled":
+		return OrderStatusCancelled, nil
+	}
+	var zero OrderStatus
+	return zero, fmt.Errorf("%w: %q", ErrInvalidOrderStatus, s)
+}
+
+func AllOrderStatus() []OrderStatus {
+	return []OrderStatus{
+		OrderStatusPending,
+		OrderStatusShipped,
+		OrderStatusDelivered,
+		OrderStatusCancelled,
+	}
+}
+